@@ -77,9 +77,9 @@ func (service *BotsFbService) GetBots(ctx context.Context) ([]*FbBot, error) {
 }
 
 type FbBotContact struct {
-	ID          string `json:"id"`
-	BotID       string `json:"bot_id"`
-	Status      int    `json:"status"`
+	ID          string           `json:"id"`
+	BotID       string           `json:"bot_id"`
+	Status      BotContactStatus `json:"status"`
 	ChannelData struct {
 		ID         string `json:"id"`
 		Name       string `json:"name"`
@@ -459,7 +459,7 @@ type FbBotMessage struct {
 	BotID        string                 `json:"bot_id"`
 	CampaignID   string                 `json:"campaign_id"`
 	Data         map[string]interface{} `json:"data"`
-	Direction    int                    `json:"direction"`
+	Direction    MessageDirection       `json:"direction"`
 	Status       int                    `json:"status"`
 	DeliveredAt  time.Time              `json:"delivered_at"`
 	OpenedAt     time.Time              `json:"opened_at"`
@@ -516,7 +516,7 @@ func (suite *SendpulseTestSuite) TestBotsFbService_GetContactMessages() {
 		}`)
 	})
 
-	messages, err := suite.client.Bots.Fb.GetContactMessages(context.Background(), "bot")
+	messages, err := suite.client.Bots.Fb.GetContactMessages(context.Background(), "bot", nil, nil, nil)
 	suite.NoError(err)
 	suite.Equal("string", messages[0].ID)
 }
@@ -125,9 +125,9 @@ func (service *BotsIgService) GetBots(ctx context.Context) ([]*IgBot, error) {
 }
 
 type IgBotContact struct {
-	ID          string `json:"id"`
-	BotID       string `json:"bot_id"`
-	Status      int    `json:"status"`
+	ID          string           `json:"id"`
+	BotID       string           `json:"bot_id"`
+	Status      BotContactStatus `json:"status"`
 	ChannelData struct {
 		ID         int64  `json:"id"`
 		UserName   string `json:"user_name"`
@@ -499,7 +499,7 @@ type IgBotMessage struct {
 	BotID      string                 `json:"bot_id"`
 	CampaignID string                 `json:"campaign_id"`
 	Data       map[string]interface{} `json:"data"`
-	Direction  int                    `json:"direction"`
+	Direction  MessageDirection       `json:"direction"`
 	Status     int                    `json:"status"`
 	CreatedAt  time.Time              `json:"created_at"`
 	Type       string                 `json:"type"`
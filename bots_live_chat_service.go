@@ -73,9 +73,9 @@ func (service *BotsLiveChatService) GetBots(ctx context.Context) ([]*LiveChatBot
 }
 
 type LiveChatBotContact struct {
-	ID          string `json:"id"`
-	BotID       string `json:"bot_id"`
-	Status      int    `json:"status"`
+	ID          string           `json:"id"`
+	BotID       string           `json:"bot_id"`
+	Status      BotContactStatus `json:"status"`
 	ChannelData struct {
 		ID         string `json:"id"`
 		UserName   string `json:"user_name"`
@@ -441,7 +441,7 @@ type LiveChatBotMessage struct {
 	BotID      string                 `json:"bot_id"`
 	CampaignID string                 `json:"campaign_id"`
 	Data       map[string]interface{} `json:"data"`
-	Direction  int                    `json:"direction"`
+	Direction  MessageDirection       `json:"direction"`
 	Status     int                    `json:"status"`
 	CreatedAt  time.Time              `json:"created_at"`
 	Type       string                 `json:"type"`
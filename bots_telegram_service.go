@@ -84,9 +84,9 @@ func (service *BotsTelegramService) GetBots(ctx context.Context) ([]*TelegramBot
 }
 
 type TelegramBotContact struct {
-	ID          string `json:"id"`
-	BotID       string `json:"bot_id"`
-	Status      int    `json:"status"`
+	ID          string           `json:"id"`
+	BotID       string           `json:"bot_id"`
+	Status      BotContactStatus `json:"status"`
 	ChannelData struct {
 		Username     string `json:"username"`
 		FirstName    string `json:"first_name"`
@@ -405,7 +405,7 @@ type TelegramBotMessage struct {
 	BotID      string                 `json:"bot_id"`
 	CampaignID string                 `json:"campaign_id"`
 	Data       map[string]interface{} `json:"data"`
-	Direction  int                    `json:"direction"`
+	Direction  MessageDirection       `json:"direction"`
 	Status     int                    `json:"status"`
 	CreatedAt  time.Time              `json:"created_at"`
 }
@@ -76,9 +76,9 @@ func (service *BotsVkService) GetBots(ctx context.Context) ([]*VkBot, error) {
 }
 
 type VkBotContact struct {
-	ID          string `json:"id"`
-	BotID       string `json:"bot_id"`
-	Status      int    `json:"status"`
+	ID          string           `json:"id"`
+	BotID       string           `json:"bot_id"`
+	Status      BotContactStatus `json:"status"`
 	ChannelData struct {
 		GroupID  int         `json:"group_id"`
 		IsMember bool        `json:"is_member"`
@@ -396,7 +396,7 @@ type VkBotMessage struct {
 	BotID      string                 `json:"bot_id"`
 	CampaignID string                 `json:"campaign_id"`
 	Data       map[string]interface{} `json:"data"`
-	Direction  int                    `json:"direction"`
+	Direction  MessageDirection       `json:"direction"`
 	Status     int                    `json:"status"`
 	CreatedAt  time.Time              `json:"created_at"`
 }
@@ -74,9 +74,9 @@ func (service *BotsWhatsAppService) GetBots(ctx context.Context) ([]*WhatsAppBot
 }
 
 type WhatsAppBotContact struct {
-	ID          string `json:"id"`
-	BotID       string `json:"bot_id"`
-	Status      int    `json:"status"`
+	ID          string           `json:"id"`
+	BotID       string           `json:"bot_id"`
+	Status      BotContactStatus `json:"status"`
 	ChannelData struct {
 		UserName     string `json:"username"`
 		FirstName    string `json:"first_name"`
@@ -109,6 +109,10 @@ type WhatsAppMessage struct {
 
 func (service *BotsWhatsAppService) CreateContact(ctx context.Context, botID, phone, name string) (*WhatsAppBotContact, error) {
 	path := "/whatsapp/contacts"
+	phone, err := service.client.preparePhone(phone)
+	if err != nil {
+		return nil, err
+	}
 
 	type bodyFormat struct {
 		Phone string `json:"phone"`
@@ -125,7 +129,7 @@ func (service *BotsWhatsAppService) CreateContact(ctx context.Context, botID, ph
 		Success bool                `json:"success"`
 		Data    *WhatsAppBotContact `json:"data"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
 	return respData.Data, err
 }
 
@@ -205,6 +209,10 @@ func (service *BotsWhatsAppService) SendByContact(ctx context.Context, contactID
 
 func (service *BotsWhatsAppService) SendByPhone(ctx context.Context, botID, phone string, message *WhatsAppMessage) error {
 	path := "/whatsapp/contacts/sendByPhone"
+	phone, err := service.client.preparePhone(phone)
+	if err != nil {
+		return err
+	}
 
 	type bodyFormat struct {
 		BotID   string           `json:"bot_id"`
@@ -220,7 +228,7 @@ func (service *BotsWhatsAppService) SendByPhone(ctx context.Context, botID, phon
 	var respData struct {
 		Success bool `json:"success"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
 	return err
 }
 
@@ -390,6 +398,10 @@ func (service *BotsWhatsAppService) SendTemplateWithImage(ctx context.Context, c
 
 func (service *BotsWhatsAppService) SendTemplateByPhone(ctx context.Context, botID, phone, templateName, languageCode string) error {
 	path := "/whatsapp/contacts/sendTemplateByPhone"
+	phone, err := service.client.preparePhone(phone)
+	if err != nil {
+		return err
+	}
 
 	type bodyFormat struct {
 		BotID    string `json:"bot_id"`
@@ -422,12 +434,16 @@ func (service *BotsWhatsAppService) SendTemplateByPhone(ctx context.Context, bot
 	var respData struct {
 		Success bool `json:"success"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
 	return err
 }
 
 func (service *BotsWhatsAppService) SendTemplateByPhoneWithVariables(ctx context.Context, botID, phone, templateName, languageCode string, variables []string) error {
 	path := "/whatsapp/contacts/sendTemplateByPhone"
+	phone, err := service.client.preparePhone(phone)
+	if err != nil {
+		return err
+	}
 
 	type bodyComponentVariableFormat struct {
 		Type string `json:"type"`
@@ -486,12 +502,16 @@ func (service *BotsWhatsAppService) SendTemplateByPhoneWithVariables(ctx context
 	var respData struct {
 		Success bool `json:"success"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
 	return err
 }
 
 func (service *BotsWhatsAppService) SendTemplateByPhoneWithImage(ctx context.Context, botID, phone, templateName, languageCode, imageLink string) error {
 	path := "/whatsapp/contacts/sendTemplateByPhone"
+	phone, err := service.client.preparePhone(phone)
+	if err != nil {
+		return err
+	}
 
 	type bodyComponentImageFormat struct {
 		Type  string `json:"type"`
@@ -554,7 +574,7 @@ func (service *BotsWhatsAppService) SendTemplateByPhoneWithImage(ctx context.Con
 	var respData struct {
 		Success bool `json:"success"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, body, &respData, true)
 	return err
 }
 
@@ -798,7 +818,7 @@ type WhatsAppBotMessage struct {
 	BotID      string                 `json:"bot_id"`
 	CampaignID string                 `json:"campaign_id"`
 	Data       map[string]interface{} `json:"data"`
-	Direction  int                    `json:"direction"`
+	Direction  MessageDirection       `json:"direction"`
 	Status     int                    `json:"status"`
 	CreatedAt  time.Time              `json:"created_at"`
 }
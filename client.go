@@ -9,7 +9,9 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"sync"
+	"time"
 )
 
 const apiBaseUrl = "https://api.sendpulse.com"
@@ -33,6 +35,8 @@ type Client struct {
 	config        *Config
 	token         string
 	tokenLock     *sync.RWMutex
+	location      *time.Location
+	locationLock  *sync.RWMutex
 	rateLimiter   *rate.Limiter
 	Emails        *EmailsService
 	Balance       *BalanceService
@@ -51,11 +55,18 @@ func NewClient(client *http.Client, config *Config) *Client {
 		config.Rps = 10
 	}
 
+	location := config.Location
+	if location == nil {
+		location = time.UTC
+	}
+
 	cl := &Client{
-		client:    client,
-		config:    config,
-		token:     "",
-		tokenLock: new(sync.RWMutex),
+		client:       client,
+		config:       config,
+		token:        "",
+		tokenLock:    new(sync.RWMutex),
+		location:     location,
+		locationLock: new(sync.RWMutex),
 	}
 	cl.Emails = newEmailsService(cl)
 	cl.Balance = newBalanceService(cl)
@@ -70,6 +81,41 @@ func NewClient(client *http.Client, config *Config) *Client {
 	return cl
 }
 
+// Location returns the timezone of the SendPulse account used to parse and format dates
+func (c *Client) Location() *time.Location {
+	c.locationLock.RLock()
+	defer c.locationLock.RUnlock()
+	return c.location
+}
+
+// SetAccountTimezone sets the location of dates from the timezone of the SendPulse account settings.
+// Both IANA names (e.g. "Europe/Kyiv") and offsets as shown in the settings (e.g. "(GMT+03:00) Kyiv", "UTC+3") are accepted
+func (c *Client) SetAccountTimezone(timezone string) error {
+	loc, err := parseTimezone(timezone)
+	if err != nil {
+		return err
+	}
+	c.locationLock.Lock()
+	c.location = loc
+	c.locationLock.Unlock()
+	return nil
+}
+
+// accountDate converts a date of a request to the account location
+func (c *Client) accountDate(date DateTimeType) DateTimeType {
+	if date.IsZero() {
+		return date
+	}
+	return DateTimeType(date.Time().In(c.Location()))
+}
+
+// localizeResult moves dates of a decoded response to the account location
+func (c *Client) localizeResult(result interface{}) {
+	if loc := c.Location(); loc != time.UTC {
+		localizeDates(reflect.ValueOf(result), loc)
+	}
+}
+
 // getToken returns new token to interact with Sendpulse or returns it from stored value if it already exists
 func (c *Client) getToken(ctx context.Context) (string, error) {
 	c.tokenLock.RLock()
@@ -170,6 +216,7 @@ func (c *Client) newRequest(ctx context.Context, method string, path string, bod
 	if err := json.Unmarshal(respBody, &result); err != nil {
 		return nil, &SendpulseError{resp.StatusCode, path, string(respBody), err.Error()}
 	}
+	c.localizeResult(result)
 
 	return resp, nil
 }
@@ -219,6 +266,7 @@ func (c *Client) newFormDataRequest(ctx context.Context, path string, buffer *by
 	if err := json.Unmarshal(respBody, &result); err != nil {
 		return nil, &SendpulseError{resp.StatusCode, path, string(respBody), err.Error()}
 	}
+	c.localizeResult(result)
 
 	return resp, nil
 }
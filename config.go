@@ -1,7 +1,13 @@
 package sendpulse_sdk_go
 
+import "time"
+
 type Config struct {
-	UserID string
-	Secret string
-	Rps    int // Max allowed count of requests per second (default: 10)
+	UserID              string
+	Secret              string
+	Rps                 int    // Max allowed count of requests per second (default: 10)
+	NormalizePhones     bool   // Normalize and validate phone numbers locally before sending them to SendPulse
+	DefaultPhoneCountry string // ISO 3166-1 alpha-2 country code of phone numbers without international prefix (e.g. "UA")
+	// Location is the timezone of the SendPulse account used to parse and format dates (default: UTC)
+	Location *time.Location
 }
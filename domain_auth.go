@@ -0,0 +1,214 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Names of domain authentication checks
+const (
+	DomainAuthCheckSPF   = "spf"
+	DomainAuthCheckDKIM  = "dkim"
+	DomainAuthCheckDMARC = "dmarc"
+)
+
+// DnsResolver resolves TXT records. net.Resolver implements it
+type DnsResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// DomainAuthReport represents results of SPF, DKIM and DMARC checks of a sending domain
+type DomainAuthReport struct {
+	PreflightChecks
+	Domain string
+	// Records contains matching TXT records found per check name
+	Records map[string][]string
+}
+
+// DomainAuthChecker checks that a sending domain publishes SPF, DKIM and DMARC records SendPulse requires
+type DomainAuthChecker struct {
+	// Resolver resolves TXT records (default: net.DefaultResolver)
+	Resolver DnsResolver
+	// DkimSelector is the DKIM selector to check (default: SendpulseDkimSelector)
+	DkimSelector string
+}
+
+// NewDomainAuthChecker creates DomainAuthChecker. A nil resolver uses net.DefaultResolver
+func NewDomainAuthChecker(resolver DnsResolver) *DomainAuthChecker {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &DomainAuthChecker{Resolver: resolver, DkimSelector: SendpulseDkimSelector}
+}
+
+// CheckSender checks the domain of a sender email
+func (c *DomainAuthChecker) CheckSender(ctx context.Context, email string) (*DomainAuthReport, error) {
+	domain := emailDomain(email)
+	if domain == "" {
+		return nil, fmt.Errorf("invalid email %q", email)
+	}
+	return c.Check(ctx, domain)
+}
+
+// Check resolves SPF, DKIM and DMARC records of the domain and compares them with SendPulse requirements.
+// Missing records fail the checks, only a cancelled context is returned as an error
+func (c *DomainAuthChecker) Check(ctx context.Context, domain string) (*DomainAuthReport, error) {
+	domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+	if domain == "" {
+		return nil, fmt.Errorf("domain is empty")
+	}
+
+	selector := c.DkimSelector
+	if selector == "" {
+		selector = SendpulseDkimSelector
+	}
+	report := &DomainAuthReport{Domain: domain, Records: make(map[string][]string)}
+	for _, record := range requiredDnsRecords(domain, selector) {
+		name := strings.ToLower(record.Purpose)
+		check := &PreflightCheck{Name: name}
+		values, ok := c.lookup(ctx, check, record)
+		if ok {
+			switch name {
+			case DomainAuthCheckSPF:
+				checkSPF(check, record, values)
+			case DomainAuthCheckDKIM:
+				values = checkDKIM(check, record, values)
+			case DomainAuthCheckDMARC:
+				checkDMARC(check, record, values)
+			}
+		}
+		if len(values) != 0 {
+			report.Records[name] = values
+		}
+		report.PreflightChecks = append(report.PreflightChecks, check)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func checkSPF(check *PreflightCheck, record *DnsRecord, values []string) {
+	switch {
+	case len(values) == 0:
+		check.Message = "no SPF record at " + record.Name
+		check.Hint = publishHint(record)
+	case len(values) > 1:
+		check.Message = fmt.Sprintf("%d SPF records found, only one is allowed", len(values))
+		check.Hint = fmt.Sprintf("merge them into a single record including include:%s", SendpulseSpfInclude)
+	default:
+		terms := strings.Fields(strings.ToLower(values[0]))
+		switch {
+		case !containsFold(terms, "include:"+SendpulseSpfInclude):
+			check.Message = fmt.Sprintf("include:%s is missing", SendpulseSpfInclude)
+			check.Hint = fmt.Sprintf("add include:%s before the all mechanism", SendpulseSpfInclude)
+		case containsFold(terms, "+all") || containsFold(terms, "all"):
+			check.Message = "record allows any server to send mail"
+			check.Hint = "replace +all with ~all or -all"
+		default:
+			check.Passed = true
+		}
+	}
+}
+
+// checkDKIM checks DKIM records among the values and returns them
+func checkDKIM(check *PreflightCheck, record *DnsRecord, values []string) []string {
+	var records []string
+	for _, value := range values {
+		tags := parseDnsTags(value)
+		if _, ok := tags["p"]; ok || strings.EqualFold(tags["v"], "DKIM1") {
+			records = append(records, value)
+		}
+	}
+
+	switch {
+	case len(records) == 0:
+		check.Message = "no DKIM record at " + record.Name
+		check.Hint = publishHint(record)
+	case len(records) > 1:
+		check.Message = fmt.Sprintf("%d DKIM records found, only one is allowed", len(records))
+		check.Hint = "keep only the record with the key from SendPulse settings"
+	case parseDnsTags(records[0])["p"] == "":
+		check.Message = "public key is empty or revoked"
+		check.Hint = publishHint(record)
+	default:
+		check.Passed = true
+	}
+	return records
+}
+
+func checkDMARC(check *PreflightCheck, record *DnsRecord, values []string) {
+	switch {
+	case len(values) == 0:
+		check.Message = "no DMARC record at " + record.Name
+		check.Hint = publishHint(record)
+	case len(values) > 1:
+		check.Message = fmt.Sprintf("%d DMARC records found, only one is allowed", len(values))
+		check.Hint = "merge them into a single record"
+	default:
+		switch policy := strings.ToLower(parseDnsTags(values[0])["p"]); policy {
+		case "none":
+			check.Passed = true
+			check.Message = "policy none only monitors, consider quarantine or reject once reports are clean"
+		case "quarantine", "reject":
+			check.Passed = true
+		case "":
+			check.Message = "policy tag p is missing"
+			check.Hint = "add p=none, p=quarantine or p=reject"
+		default:
+			check.Message = fmt.Sprintf("unknown policy %q", policy)
+			check.Hint = "use p=none, p=quarantine or p=reject"
+		}
+	}
+}
+
+// publishHint describes how to publish a required record
+func publishHint(record *DnsRecord) string {
+	return fmt.Sprintf("publish %s record %q at %s", record.Type, record.Value, record.Name)
+}
+
+// lookup resolves TXT records of the required record, keeping those with the prefix of its value.
+// It returns false if the lookup failed for a reason other than a missing record
+func (c *DomainAuthChecker) lookup(ctx context.Context, check *PreflightCheck, record *DnsRecord) ([]string, bool) {
+	values, err := c.Resolver.LookupTXT(ctx, record.Name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			check.Message = fmt.Sprintf("lookup of %s failed: %s", record.Name, err)
+			check.Hint = "check that the domain's name servers respond and retry"
+			return nil, false
+		}
+	}
+
+	// the DKIM value has no fixed prefix, its tags are checked instead
+	var prefix string
+	if check.Name != DomainAuthCheckDKIM {
+		prefix = strings.ToLower(strings.Fields(record.Value)[0])
+		prefix = strings.TrimSuffix(prefix, ";")
+	}
+	var matched []string
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(strings.ToLower(value), prefix) {
+			matched = append(matched, value)
+		}
+	}
+	return matched, true
+}
+
+// parseDnsTags parses "tag=value; tag=value" records used by DKIM and DMARC
+func parseDnsTags(record string) map[string]string {
+	tags := make(map[string]string)
+	for _, part := range strings.Split(record, ";") {
+		eq := strings.Index(part, "=")
+		if eq < 0 {
+			continue
+		}
+		name := strings.ToLower(strings.TrimSpace(part[:eq]))
+		tags[name] = strings.Join(strings.Fields(part[eq+1:]), "")
+	}
+	return tags
+}
@@ -0,0 +1,60 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+type fakeDnsResolver map[string][]string
+
+func (r fakeDnsResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if name == "_dmarc.broken.com" {
+		return nil, errors.New("server misbehaving")
+	}
+	values, ok := r[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return values, nil
+}
+
+func (suite *SendpulseTestSuite) TestDomainAuthChecker_Check() {
+	resolver := fakeDnsResolver{
+		"test.com":                   {"google-site-verification=abc", "v=spf1 include:_spf.google.com include:mxsmtp.sendpulse.com ~all"},
+		"sign._domainkey.test.com":   {"v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQC"},
+		"_dmarc.test.com":            {"v=DMARC1; p=quarantine; rua=mailto:dmarc@test.com"},
+		"broken.com":                 {"v=spf1 include:_spf.google.com +all", "v=spf1 -all"},
+		"sign._domainkey.broken.com": {"v=DKIM1; k=rsa; p="},
+	}
+	checker := NewDomainAuthChecker(resolver)
+
+	report, err := checker.CheckSender(context.Background(), "alex@Test.com")
+	suite.NoError(err)
+	suite.Equal("test.com", report.Domain)
+	suite.True(report.OK(), report.String())
+	suite.Equal(1, len(report.Records[DomainAuthCheckSPF]))
+
+	report, err = checker.Check(context.Background(), "broken.com")
+	suite.NoError(err)
+	suite.False(report.OK())
+	suite.Equal(3, len(report.Failed()))
+	suite.Contains(report.Check(DomainAuthCheckSPF).Message, "only one is allowed")
+	suite.Contains(report.Check(DomainAuthCheckDKIM).Message, "revoked")
+	suite.Contains(report.Check(DomainAuthCheckDMARC).Message, "lookup of _dmarc.broken.com failed")
+	suite.Contains(report.String(), "fix: ")
+
+	report, err = checker.Check(context.Background(), "missing.com")
+	suite.NoError(err)
+	suite.Equal("no SPF record at missing.com", report.Check(DomainAuthCheckSPF).Message)
+	suite.Contains(report.Check(DomainAuthCheckSPF).Hint, RequiredDnsRecords("missing.com")[0].Value)
+	suite.Equal("no DMARC record at _dmarc.missing.com", report.Check(DomainAuthCheckDMARC).Message)
+
+	resolver["missing.com"] = []string{"v=spf1 include:_spf.google.com all"}
+	report, err = checker.Check(context.Background(), "missing.com")
+	suite.NoError(err)
+	suite.Contains(report.Check(DomainAuthCheckSPF).Message, "is missing")
+
+	_, err = checker.CheckSender(context.Background(), "invalid")
+	suite.Error(err)
+}
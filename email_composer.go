@@ -0,0 +1,214 @@
+package sendpulse_sdk_go
+
+import (
+	b64 "encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// DefaultMaxEmailSize is the max total size of an email body and its attachments accepted by SendPulse
+const DefaultMaxEmailSize = 10 * 1024 * 1024
+
+// EmailAttachment represents a file attached to an email.
+// SendPulse derives the content type of an attachment from its name, so ContentType is only used
+// to add a missing file extension to the name. An inline image is sent as an attachment
+// and referenced in the body as "cid:<name>"
+type EmailAttachment struct {
+	Name        string
+	ContentType string
+	Content     []byte
+	Inline      bool
+}
+
+var cidReferenceRe = regexp.MustCompile(`cid:([^"'\s)>]+)`)
+
+// encodedSize returns the size of the base64 encoded attachment
+func (a *EmailAttachment) encodedSize() int {
+	return b64.StdEncoding.EncodedLen(len(a.Content))
+}
+
+// EmailSizeError is returned when an email exceeds the max allowed size
+type EmailSizeError struct {
+	Size    int
+	MaxSize int
+}
+
+// Error returns string representation of the EmailSizeError
+func (e *EmailSizeError) Error() string {
+	return fmt.Sprintf("email size %d bytes exceeds the limit of %d bytes", e.Size, e.MaxSize)
+}
+
+// EmailComposer collects attachments and inline images of an email and fills SMTP or campaign params with them
+type EmailComposer struct {
+	MaxSize     int // Max total size of the encoded body and attachments (default: DefaultMaxEmailSize)
+	attachments []*EmailAttachment
+}
+
+// NewEmailComposer creates EmailComposer
+func NewEmailComposer() *EmailComposer {
+	return &EmailComposer{MaxSize: DefaultMaxEmailSize}
+}
+
+// Attachments returns the added attachments
+func (c *EmailComposer) Attachments() []*EmailAttachment {
+	return c.attachments
+}
+
+// AddAttachment reads an attachment from reader and detects its MIME type.
+// An extension matching the detected type is added to a name without one
+func (c *EmailComposer) AddAttachment(name string, reader io.Reader) (*EmailAttachment, error) {
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return c.addContent(name, content, false)
+}
+
+// AddAttachmentFile reads an attachment from a file
+func (c *EmailComposer) AddAttachmentFile(path string) (*EmailAttachment, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return c.addContent(filepath.Base(path), content, false)
+}
+
+// AddInlineImage reads an image and returns its "cid:" reference to be used in the email body
+func (c *EmailComposer) AddInlineImage(name string, reader io.Reader) (string, error) {
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	attachment, err := c.addContent(name, content, true)
+	if err != nil {
+		return "", err
+	}
+	return "cid:" + attachment.Name, nil
+}
+
+// AddInlineImageFile reads an inline image from a file and returns its "cid:" reference
+func (c *EmailComposer) AddInlineImageFile(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	attachment, err := c.addContent(filepath.Base(path), content, true)
+	if err != nil {
+		return "", err
+	}
+	return "cid:" + attachment.Name, nil
+}
+
+// Size returns the total size of the encoded body and attachments
+func (c *EmailComposer) Size(body string) int {
+	size := b64.StdEncoding.EncodedLen(len(body))
+	for _, attachment := range c.attachments {
+		size += attachment.encodedSize()
+	}
+	return size
+}
+
+// Validate checks that "cid:" references of the body match inline images and the email fits the size limit
+func (c *EmailComposer) Validate(body string) error {
+	inline := make(map[string]bool)
+	for _, attachment := range c.attachments {
+		if attachment.Inline {
+			if !strings.Contains(body, "cid:"+attachment.Name) {
+				return fmt.Errorf("inline image %q is not referenced in the body", attachment.Name)
+			}
+			inline[attachment.Name] = true
+		}
+	}
+	for _, match := range cidReferenceRe.FindAllStringSubmatch(body, -1) {
+		if !inline[match[1]] {
+			return fmt.Errorf("body references %q which is not an inline image", match[0])
+		}
+	}
+
+	maxSize := c.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxEmailSize
+	}
+	if size := c.Size(body); size > maxSize {
+		return &EmailSizeError{Size: size, MaxSize: maxSize}
+	}
+	return nil
+}
+
+// ApplyToSmtp validates the email and fills attachments of SMTP params
+func (c *EmailComposer) ApplyToSmtp(params *SendEmailParams) error {
+	if err := c.Validate(params.Html); err != nil {
+		return err
+	}
+	if len(c.attachments) == 0 {
+		return nil
+	}
+	if params.AttachmentsBinary == nil {
+		params.AttachmentsBinary = make(map[string]string, len(c.attachments))
+	}
+	for _, attachment := range c.attachments {
+		params.AttachmentsBinary[attachment.Name] = b64.StdEncoding.EncodeToString(attachment.Content)
+	}
+	return nil
+}
+
+// ApplyToCampaign validates the email and fills attachments of campaign params
+func (c *EmailComposer) ApplyToCampaign(params *CampaignParams) error {
+	if err := c.Validate(params.Body); err != nil {
+		return err
+	}
+	if len(c.attachments) == 0 {
+		return nil
+	}
+	if params.Attachments == nil {
+		params.Attachments = make(map[string]string, len(c.attachments))
+	}
+	for _, attachment := range c.attachments {
+		params.Attachments[attachment.Name] = b64.StdEncoding.EncodeToString(attachment.Content)
+	}
+	return nil
+}
+
+func (c *EmailComposer) addContent(name string, content []byte, inline bool) (*EmailAttachment, error) {
+	if name == "" {
+		return nil, fmt.Errorf("attachment name is empty")
+	}
+	contentType := detectContentType(name, content)
+	if inline && !strings.HasPrefix(contentType, "image/") {
+		return nil, fmt.Errorf("inline attachment %q is not an image: %s", name, contentType)
+	}
+	if filepath.Ext(name) == "" {
+		if extensions, _ := mime.ExtensionsByType(contentType); len(extensions) != 0 {
+			name += extensions[0]
+		}
+	}
+	for _, attachment := range c.attachments {
+		if attachment.Name == name {
+			return nil, fmt.Errorf("attachment %q is already added", name)
+		}
+	}
+
+	attachment := &EmailAttachment{
+		Name:        name,
+		ContentType: contentType,
+		Content:     content,
+		Inline:      inline,
+	}
+
+	c.attachments = append(c.attachments, attachment)
+	return attachment, nil
+}
+
+// detectContentType detects MIME type by the file extension or by the content
+func detectContentType(name string, content []byte) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType
+	}
+	return http.DetectContentType(content)
+}
@@ -0,0 +1,95 @@
+package sendpulse_sdk_go
+
+import (
+	"bytes"
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func (suite *SendpulseTestSuite) TestEmailComposer_Smtp() {
+	dir, err := ioutil.TempDir("", "composer")
+	suite.NoError(err)
+	defer os.RemoveAll(dir)
+	filePath := filepath.Join(dir, "report.pdf")
+	suite.NoError(ioutil.WriteFile(filePath, []byte("%PDF-1.4"), 0600))
+
+	composer := NewEmailComposer()
+	attachment, err := composer.AddAttachmentFile(filePath)
+	suite.NoError(err)
+	suite.Equal("application/pdf", attachment.ContentType)
+
+	logo, err := composer.AddAttachment("logo", bytes.NewReader(pngHeader))
+	suite.NoError(err)
+	suite.Equal("logo.png", logo.Name)
+	suite.Equal("image/png", logo.ContentType)
+
+	cid, err := composer.AddInlineImage("banner", bytes.NewReader(pngHeader))
+	suite.NoError(err)
+	suite.Equal("cid:banner.png", cid)
+
+	suite.mux.HandleFunc("/smtp/emails", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Email SendEmailParams `json:"email"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		suite.Equal(b64.StdEncoding.EncodeToString([]byte("%PDF-1.4")), body.Email.AttachmentsBinary["report.pdf"])
+		suite.Contains(body.Email.AttachmentsBinary, "logo.png")
+		suite.Contains(body.Email.AttachmentsBinary, "banner.png")
+		html, err := b64.StdEncoding.DecodeString(body.Email.Html)
+		suite.NoError(err)
+		suite.Contains(string(html), `src="cid:banner.png"`)
+		fmt.Fprintf(w, `{"result": true, "id": "1"}`)
+	})
+
+	params := SendEmailParams{
+		Html:    fmt.Sprintf(`<h1>Report</h1><img src="%s">`, cid),
+		Subject: "Report",
+		From:    User{Name: "Alex", Email: "alex@test.com"},
+		To:      []User{{Name: "Bob", Email: "bob@test.com"}},
+	}
+	suite.NoError(composer.ApplyToSmtp(&params))
+	_, err = suite.client.SMTP.SendMessage(context.Background(), params)
+	suite.NoError(err)
+}
+
+func (suite *SendpulseTestSuite) TestEmailComposer_Campaign() {
+	composer := NewEmailComposer()
+	_, err := composer.AddAttachment("notes.txt", strings.NewReader("hello"))
+	suite.NoError(err)
+
+	params := CampaignParams{Body: "<h1>Hello</h1>"}
+	suite.NoError(composer.ApplyToCampaign(&params))
+	suite.Equal(b64.StdEncoding.EncodeToString([]byte("hello")), params.Attachments["notes.txt"])
+}
+
+func (suite *SendpulseTestSuite) TestEmailComposer_Validate() {
+	composer := NewEmailComposer()
+	_, err := composer.AddAttachment("logo.png", bytes.NewReader(pngHeader))
+	suite.NoError(err)
+	suite.NoError(composer.Validate("<h1>Hello</h1>"))
+
+	_, err = composer.AddAttachment("logo.png", bytes.NewReader(pngHeader))
+	suite.Error(err)
+
+	_, err = composer.AddInlineImage("notes.txt", strings.NewReader("hello"))
+	suite.Error(err)
+
+	cid, err := composer.AddInlineImage("banner.png", bytes.NewReader(pngHeader))
+	suite.NoError(err)
+	suite.Error(composer.Validate("<h1>Hello</h1>"))
+	suite.Error(composer.Validate(fmt.Sprintf(`<img src="%s"><img src="cid:missing.png">`, cid)))
+	suite.NoError(composer.Validate(fmt.Sprintf(`<img src="%s">`, cid)))
+
+	composer.MaxSize = 10
+	err = composer.Validate(fmt.Sprintf(`<img src="%s">`, cid))
+	suite.IsType(&EmailSizeError{}, err)
+}
@@ -0,0 +1,187 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Sources of a subscriber profile
+const (
+	ProfileSourceInfo        = "info"
+	ProfileSourceDetails     = "details"
+	ProfileSourceStatistics  = "statistics"
+	ProfileSourceUnsubscribe = "smtp_unsubscribe"
+	ProfileSourceValidation  = "validation"
+)
+
+// SubscriberMailingList represents an email address in a mailing list
+type SubscriberMailingList struct {
+	ID        int                    `json:"id"`
+	Name      string                 `json:"name"`
+	Status    SubscriberStatus       `json:"status"`
+	AddDate   DateTimeType           `json:"add_date"`
+	Source    string                 `json:"source"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// SubscriberProfile aggregates all information about an email address
+type SubscriberProfile struct {
+	Email        string                   `json:"email"`
+	MailingLists []*SubscriberMailingList `json:"mailing_lists"`
+	Sent         int                      `json:"sent"`
+	Opened       int                      `json:"opened"`
+	Clicked      int                      `json:"clicked"`
+	Blacklisted  bool                     `json:"blacklisted"`
+	// Unsubscribed is set if the email is unsubscribed from SMTP emails
+	Unsubscribed *Unsubscribed          `json:"unsubscribed,omitempty"`
+	Validation   *EmailValidationResult `json:"validation,omitempty"`
+}
+
+// MailingList returns the mailing list of the profile by its ID or nil
+func (p *SubscriberProfile) MailingList(id int) *SubscriberMailingList {
+	for _, list := range p.MailingLists {
+		if list.ID == id {
+			return list
+		}
+	}
+	return nil
+}
+
+// SubscriberProfileError is returned when some sources of a subscriber profile failed
+type SubscriberProfileError struct {
+	Errors map[string]error
+}
+
+// Error returns string representation of the SubscriberProfileError
+func (e *SubscriberProfileError) Error() string {
+	sources := make([]string, 0, len(e.Errors))
+	for source := range e.Errors {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	messages := make([]string, len(sources))
+	for i, source := range sources {
+		messages[i] = fmt.Sprintf("%s: %v", source, e.Errors[source])
+	}
+	return "subscriber profile is incomplete: " + strings.Join(messages, "; ")
+}
+
+// SubscriberProfileOptions describes options of GetSubscriberProfile
+type SubscriberProfileOptions struct {
+	// SmtpUnsubscribed is the SMTP unsubscribe list fetched beforehand with SmtpService.GetAllUnsubscribedEmails.
+	// SendPulse can't look up a single address in this list, so pass it when building many profiles
+	SmtpUnsubscribed []Unsubscribed
+	// CheckSmtpUnsubscribe downloads the whole SMTP unsubscribe list if SmtpUnsubscribed is nil.
+	// It takes a request per 100 addresses of the list on every call
+	CheckSmtpUnsubscribe bool
+}
+
+// GetSubscriberProfile requests all information about an email address concurrently.
+// It takes four requests, plus the SMTP unsubscribe list download if options.CheckSmtpUnsubscribe is set.
+// If some requests fail, the profile is filled from the rest and SubscriberProfileError is returned
+func (service *AddressService) GetSubscriberProfile(ctx context.Context, email string, options SubscriberProfileOptions) (*SubscriberProfile, error) {
+	emails := service.client.Emails
+	var (
+		info         []*EmailInfo
+		details      []*EmailInfoList
+		statistics   *CampaignsEmailStatistics
+		unsubscribed = options.SmtpUnsubscribed
+		validation   *EmailValidationResult
+	)
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	errs := make(map[string]error)
+	fetch := func(source string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				lock.Lock()
+				errs[source] = err
+				lock.Unlock()
+			}
+		}()
+	}
+
+	fetch(ProfileSourceInfo, func() (err error) {
+		info, err = service.GetEmailInfo(ctx, email)
+		return
+	})
+	fetch(ProfileSourceDetails, func() (err error) {
+		details, err = service.GetDetails(ctx, email)
+		return
+	})
+	fetch(ProfileSourceStatistics, func() (err error) {
+		statistics, err = service.GetEmailStatisticsByCampaignsAndAddressBooks(ctx, email)
+		return
+	})
+	if unsubscribed == nil && options.CheckSmtpUnsubscribe {
+		fetch(ProfileSourceUnsubscribe, func() (err error) {
+			unsubscribed, err = service.client.SMTP.GetAllUnsubscribedEmails(ctx, 0)
+			return
+		})
+	}
+	fetch(ProfileSourceValidation, func() (err error) {
+		validation, err = emails.Validator.GetEmailValidationResult(ctx, email)
+		return
+	})
+	wg.Wait()
+
+	profile := &SubscriberProfile{Email: email, Validation: validation}
+	lists := make(map[int]*SubscriberMailingList)
+	list := func(id int) *SubscriberMailingList {
+		if _, ok := lists[id]; !ok {
+			lists[id] = &SubscriberMailingList{ID: id}
+			profile.MailingLists = append(profile.MailingLists, lists[id])
+		}
+		return lists[id]
+	}
+
+	for _, item := range info {
+		l := list(item.BookID)
+		l.Status = item.Status
+		l.Variables = make(map[string]interface{}, len(item.Variables))
+		for _, variable := range item.Variables {
+			l.Variables[variable.Name] = variable.Value
+		}
+	}
+	for _, item := range details {
+		l := list(item.ListID)
+		l.Name = item.ListName
+		l.AddDate = item.AddDate
+		l.Source = item.Source
+	}
+	if statistics != nil {
+		if statistics.Statistic != nil {
+			profile.Sent = statistics.Statistic.Sent
+			profile.Opened = statistics.Statistic.Open
+			profile.Clicked = statistics.Statistic.Link
+		}
+		for _, item := range statistics.Addressbooks {
+			if l := list(item.Id); l.Name == "" {
+				l.Name = item.Name
+			}
+		}
+		profile.Blacklisted = statistics.Blacklist
+	}
+	sort.Slice(profile.MailingLists, func(i, j int) bool {
+		return profile.MailingLists[i].ID < profile.MailingLists[j].ID
+	})
+
+	for i := range unsubscribed {
+		if strings.EqualFold(unsubscribed[i].Email, email) {
+			profile.Unsubscribed = &unsubscribed[i]
+			break
+		}
+	}
+
+	if len(errs) != 0 {
+		return profile, &SubscriberProfileError{Errors: errs}
+	}
+	return profile, nil
+}
@@ -0,0 +1,63 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (suite *SendpulseTestSuite) TestEmailsService_AddressService_GetSubscriberProfile() {
+	suite.mux.HandleFunc("/emails/test@sendpulse.com", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"book_id": 1, "status": 1, "variables": [{"name": "name", "value": "Alex"}]}]`)
+	})
+	suite.mux.HandleFunc("/emails/test@sendpulse.com/details", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"list_name": "Customers", "list_id": 1, "add_date": "2021-01-01 10:00:00", "source": "form"}]`)
+	})
+	suite.mux.HandleFunc("/emails/test@sendpulse.com/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{
+			"statistic": {"sent": 10, "open": 5, "link": 2},
+			"addressbooks": [{"id": 1, "address_book_name": "Customers"}, {"id": 2, "address_book_name": "Leads"}],
+			"blacklist": true
+		}`)
+	})
+	suite.mux.HandleFunc("/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		suite.Fail("the blacklist must not be downloaded")
+	})
+	unsubscribeRequests := 0
+	suite.mux.HandleFunc("/smtp/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		unsubscribeRequests++
+		fmt.Fprintf(w, `[{"email": "test@sendpulse.com", "unsubscribe_by_link": 1, "date": "2021-02-01 10:00:00"}]`)
+	})
+	suite.mux.HandleFunc("/verifier-service/get-single-result/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	profile, err := suite.client.Emails.Address.GetSubscriberProfile(context.Background(), "test@sendpulse.com",
+		SubscriberProfileOptions{CheckSmtpUnsubscribe: true})
+	suite.IsType(&SubscriberProfileError{}, err)
+	suite.Contains(err.(*SubscriberProfileError).Errors, ProfileSourceValidation)
+	suite.Len(err.(*SubscriberProfileError).Errors, 1)
+
+	suite.Len(profile.MailingLists, 2)
+	customers := profile.MailingList(1)
+	suite.Equal("Customers", customers.Name)
+	suite.Equal(SubscriberStatusActive, customers.Status)
+	suite.Equal("form", customers.Source)
+	suite.Equal("Alex", customers.Variables["name"])
+	suite.Equal("Leads", profile.MailingList(2).Name)
+	suite.Equal(5, profile.Opened)
+	suite.True(profile.Blacklisted)
+	suite.Equal(1, profile.Unsubscribed.UnsubscribeByLink)
+	suite.Nil(profile.Validation)
+	suite.Equal(1, unsubscribeRequests)
+
+	unsubscribed := []Unsubscribed{{Email: "TEST@sendpulse.com", UnsubscribeByLink: 2}}
+	profile, _ = suite.client.Emails.Address.GetSubscriberProfile(context.Background(), "test@sendpulse.com",
+		SubscriberProfileOptions{SmtpUnsubscribed: unsubscribed, CheckSmtpUnsubscribe: true})
+	suite.Equal(2, profile.Unsubscribed.UnsubscribeByLink)
+	suite.Equal(1, unsubscribeRequests)
+
+	profile, _ = suite.client.Emails.Address.GetSubscriberProfile(context.Background(), "test@sendpulse.com", SubscriberProfileOptions{})
+	suite.Nil(profile.Unsubscribed)
+	suite.Equal(1, unsubscribeRequests)
+}
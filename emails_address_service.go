@@ -18,16 +18,16 @@ func newAddressService(cl *Client) *AddressService {
 
 // Variable represents a variable of email address
 type Variable struct {
-	Name  string      `json:"name"`
-	Type  string      `json:"type,omitempty"`
-	Value interface{} `json:"value"`
+	Name  string       `json:"name"`
+	Type  VariableType `json:"type,omitempty"`
+	Value interface{}  `json:"value"`
 }
 
 // EmailInfo represents a general information of email address
 type EmailInfo struct {
-	BookID    int         `json:"book_id"`
-	Status    int         `json:"status"`
-	Variables []*Variable `json:"variables"`
+	BookID    int              `json:"book_id"`
+	Status    SubscriberStatus `json:"status"`
+	Variables []*Variable      `json:"variables"`
 }
 
 // GetEmailInfo returns general information about specific email address
@@ -77,11 +77,11 @@ func (service *AddressService) GetStatisticsByCampaign(ctx context.Context, camp
 
 // AddressBookEmailStatistics represents statistics by specific address book
 type AddressBookEmailStatistics struct {
-	Email         string      `json:"email"`
-	AddressBookID int         `json:"abook_id,string"`
-	Status        int         `json:"status"`
-	StatusExplain string      `json:"status_explain"`
-	Variables     []*Variable `json:"variables"`
+	Email         string           `json:"email"`
+	AddressBookID int              `json:"abook_id,string"`
+	Status        SubscriberStatus `json:"status"`
+	StatusExplain string           `json:"status_explain"`
+	Variables     []*Variable      `json:"variables"`
 }
 
 // CampaignEmailStatistics represents statistics of specific campaign
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // MailingListsService is a service to interact with mailing lists
@@ -50,14 +51,14 @@ func (service *MailingListsService) ChangeName(ctx context.Context, id int, name
 
 // MailingList represents detailed information of specific mailing list
 type MailingList struct {
-	ID               int          `json:"id"`
-	Name             string       `json:"name"`
-	AllEmailQty      int          `json:"all_email_qty"`
-	ActiveEmailQty   int          `json:"active_email_qty"`
-	InactiveEmailQty int          `json:"inactive_email_qty"`
-	CreationDate     DateTimeType `json:"creationdate"`
-	Status           int          `json:"status"`
-	StatusExplain    string       `json:"status_explain"`
+	ID               int               `json:"id"`
+	Name             string            `json:"name"`
+	AllEmailQty      int               `json:"all_email_qty"`
+	ActiveEmailQty   int               `json:"active_email_qty"`
+	InactiveEmailQty int               `json:"inactive_email_qty"`
+	CreationDate     DateTimeType      `json:"creationdate"`
+	Status           MailingListStatus `json:"status"`
+	StatusExplain    string            `json:"status_explain"`
 }
 
 // GetMailingLists returns a list of mailing lists
@@ -80,10 +81,19 @@ func (service *MailingListsService) GetMailingList(ctx context.Context, mailingL
 	return book, err
 }
 
+// VariableType is a type of a mailing list variable
+type VariableType string
+
+const (
+	VariableTypeString VariableType = "string"
+	VariableTypeNumber VariableType = "number"
+	VariableTypeDate   VariableType = "date"
+)
+
 // VariableMeta method represents a variable of mailing list
 type VariableMeta struct {
-	Name string `json:"name"`
-	Type string `json:"type"`
+	Name string       `json:"name"`
+	Type VariableType `json:"type"`
 }
 
 // GetMailingListVariables method returns variables of specific mailing list
@@ -94,11 +104,37 @@ func (service *MailingListsService) GetMailingListVariables(ctx context.Context,
 	return variables, err
 }
 
+// CreateMailingListVariable adds a variable to a mailing list
+func (service *MailingListsService) CreateMailingListVariable(ctx context.Context, mailingListID int, variable VariableMeta) error {
+	switch variable.Type {
+	case VariableTypeString, VariableTypeNumber, VariableTypeDate:
+	default:
+		return fmt.Errorf("unsupported variable type %q", variable.Type)
+	}
+
+	path := fmt.Sprintf("/addressbooks/%d/variables", mailingListID)
+	var response struct {
+		Result bool `json:"result"`
+	}
+	_, err := service.client.newRequest(ctx, http.MethodPost, path, variable, &response, true)
+	return err
+}
+
+// DeleteMailingListVariable removes a variable from a mailing list
+func (service *MailingListsService) DeleteMailingListVariable(ctx context.Context, mailingListID int, name string) error {
+	path := fmt.Sprintf("/addressbooks/%d/variables/%s", mailingListID, url.PathEscape(name))
+	var response struct {
+		Result bool `json:"result"`
+	}
+	_, err := service.client.newRequest(ctx, http.MethodDelete, path, nil, &response, true)
+	return err
+}
+
 // Email describes email address
 type Email struct {
 	Email         string                 `json:"email"`
 	Phone         int                    `json:"phone"`
-	Status        int                    `json:"status"`
+	Status        SubscriberStatus       `json:"status"`
 	StatusExplain string                 `json:"status_explain"`
 	Variables     map[string]interface{} `json:"variables"`
 }
@@ -205,7 +241,7 @@ func (service *MailingListsService) DeleteMailingList(ctx context.Context, maili
 
 // CampaignCost represents the cost of a campaign sent to a mailing list
 type CampaignCost struct {
-	Cur                       string `json:"email"`
+	Cur                       string `json:"cur"`
 	SentEmailsQty             int    `json:"sent_emails_qty"`
 	OverdraftAllEmailsPrice   int    `json:"overdraft_all_emails_price"`
 	AddressesDeltaFromBalance int    `json:"address_delta_from_balance"`
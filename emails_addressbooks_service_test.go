@@ -254,3 +254,21 @@ func (suite *SendpulseTestSuite) TestEmailsService_AddressBooksService_UpdateEma
 	}
 	suite.NoError(suite.client.Emails.MailingLists.UpdateEmailVariables(context.Background(), 1, "test@test.com", variables))
 }
+
+func (suite *SendpulseTestSuite) TestEmailsService_MailingListsService_CreateVariable() {
+	suite.mux.HandleFunc("/addressbooks/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/addressbooks/1/variables/birth date", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodDelete, r.Method)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+
+	err := suite.client.Emails.MailingLists.CreateMailingListVariable(context.Background(), 1, VariableMeta{Name: "birth date", Type: VariableTypeDate})
+	suite.NoError(err)
+	err = suite.client.Emails.MailingLists.CreateMailingListVariable(context.Background(), 1, VariableMeta{Name: "flag", Type: "bool"})
+	suite.Error(err)
+	err = suite.client.Emails.MailingLists.DeleteMailingListVariable(context.Background(), 1, "birth date")
+	suite.NoError(err)
+}
@@ -0,0 +1,238 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultSyncPageSize         = 100
+	defaultSyncBatchSize        = 100
+	defaultSyncMaxDeletePercent = 10
+)
+
+// MailingListSyncParams describes parameters of the mailing list synchronization
+type MailingListSyncParams struct {
+	// Emails is the desired state of the mailing list
+	Emails []*EmailToAdd
+	// PageSize is the number of emails requested per page while reading the mailing list (default: 100)
+	PageSize int
+	// BatchSize is the max number of emails per add or remove request (default: 100)
+	BatchSize int
+	// MaxDeletePercent refuses to apply a plan removing more than this share (0-100) of the current emails (default: 10).
+	// Set it to 100 to disable the check
+	MaxDeletePercent float64
+	// AllowEmpty allows empty Emails, which removes all emails from the mailing list
+	AllowEmpty bool
+	// DryRun builds the plan without applying it
+	DryRun bool
+}
+
+// MailingListSyncPlan describes changes required to make a mailing list match the desired state
+type MailingListSyncPlan struct {
+	MailingListID int
+	CurrentQty    int
+	DesiredQty    int
+	Add           []*EmailToAdd
+	Remove        []string
+	Update        []*EmailToAdd
+	UnchangedQty  int
+}
+
+// DeletePercent returns the share (0-100) of the current emails the plan removes
+func (p *MailingListSyncPlan) DeletePercent() float64 {
+	if p.CurrentQty == 0 {
+		return 0
+	}
+	return float64(len(p.Remove)) * 100 / float64(p.CurrentQty)
+}
+
+// IsEmpty reports whether the plan has no changes
+func (p *MailingListSyncPlan) IsEmpty() bool {
+	return len(p.Add) == 0 && len(p.Remove) == 0 && len(p.Update) == 0
+}
+
+// String returns a human-readable representation of the plan
+func (p *MailingListSyncPlan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Mailing list %d: %d current, %d desired, %d unchanged\n", p.MailingListID, p.CurrentQty, p.DesiredQty, p.UnchangedQty)
+	for _, email := range p.Add {
+		fmt.Fprintf(&sb, "+ %s\n", email.Email)
+	}
+	for _, email := range p.Update {
+		fmt.Fprintf(&sb, "~ %s\n", email.Email)
+	}
+	for _, email := range p.Remove {
+		fmt.Fprintf(&sb, "- %s\n", email)
+	}
+	return sb.String()
+}
+
+// SyncThresholdError is returned when a sync plan exceeds MailingListSyncParams.MaxDeletePercent
+type SyncThresholdError struct {
+	DeletePercent    float64
+	MaxDeletePercent float64
+}
+
+// Error returns string representation of the SyncThresholdError
+func (e *SyncThresholdError) Error() string {
+	return fmt.Sprintf("sync would remove %.2f%% of emails, max allowed is %.2f%%", e.DeletePercent, e.MaxDeletePercent)
+}
+
+// GetAllMailingListEmails returns all emails from a mailing list reading it page by page
+func (service *MailingListsService) GetAllMailingListEmails(ctx context.Context, mailingListID int, pageSize int) ([]*Email, error) {
+	if pageSize <= 0 {
+		pageSize = defaultSyncPageSize
+	}
+
+	var emails []*Email
+	for offset := 0; ; offset += pageSize {
+		page, err := service.GetMailingListEmails(ctx, mailingListID, pageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, page...)
+		if len(page) < pageSize {
+			return emails, nil
+		}
+	}
+}
+
+// PlanSync compares the desired emails with the mailing list and returns the changes required to match them
+func (service *MailingListsService) PlanSync(ctx context.Context, mailingListID int, params MailingListSyncParams) (*MailingListSyncPlan, error) {
+	current, err := service.GetAllMailingListEmails(ctx, mailingListID, params.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	currentByEmail := make(map[string]*Email, len(current))
+	for _, email := range current {
+		currentByEmail[normalizeEmail(email.Email)] = email
+	}
+
+	plan := &MailingListSyncPlan{
+		MailingListID: mailingListID,
+		CurrentQty:    len(currentByEmail),
+	}
+
+	desired := make(map[string]bool, len(params.Emails))
+	for _, email := range params.Emails {
+		key := normalizeEmail(email.Email)
+		if key == "" || desired[key] {
+			continue
+		}
+		desired[key] = true
+
+		existing, ok := currentByEmail[key]
+		if !ok {
+			plan.Add = append(plan.Add, email)
+			continue
+		}
+		if variablesChanged(existing.Variables, email.Variables) {
+			plan.Update = append(plan.Update, email)
+			continue
+		}
+		plan.UnchangedQty++
+	}
+	plan.DesiredQty = len(desired)
+
+	for key, email := range currentByEmail {
+		if !desired[key] {
+			plan.Remove = append(plan.Remove, email.Email)
+		}
+	}
+	sort.Strings(plan.Remove)
+
+	return plan, nil
+}
+
+// Sync makes the mailing list match the desired emails: adds missing emails, removes extra emails and updates changed variables.
+// Variables which are absent in the desired state are left untouched. Empty Emails are refused unless AllowEmpty is set
+func (service *MailingListsService) Sync(ctx context.Context, mailingListID int, params MailingListSyncParams) (*MailingListSyncPlan, error) {
+	if len(params.Emails) == 0 && !params.AllowEmpty {
+		return nil, fmt.Errorf("mailing list sync has no emails: set AllowEmpty to remove all emails")
+	}
+
+	plan, err := service.PlanSync(ctx, mailingListID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDeletePercent := params.MaxDeletePercent
+	if maxDeletePercent <= 0 {
+		maxDeletePercent = defaultSyncMaxDeletePercent
+	}
+	if plan.DeletePercent() > maxDeletePercent {
+		return plan, &SyncThresholdError{DeletePercent: plan.DeletePercent(), MaxDeletePercent: maxDeletePercent}
+	}
+
+	if params.DryRun {
+		return plan, nil
+	}
+
+	batchSize := params.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSyncBatchSize
+	}
+
+	for start := 0; start < len(plan.Add); start += batchSize {
+		end := minInt(start+batchSize, len(plan.Add))
+		if err := service.SingleOptIn(ctx, mailingListID, plan.Add[start:end]); err != nil {
+			return plan, err
+		}
+	}
+
+	for _, email := range plan.Update {
+		if err := service.UpdateEmailVariables(ctx, mailingListID, email.Email, variablesFromMap(email.Variables)); err != nil {
+			return plan, err
+		}
+	}
+
+	for start := 0; start < len(plan.Remove); start += batchSize {
+		end := minInt(start+batchSize, len(plan.Remove))
+		if err := service.DeleteMailingListEmails(ctx, mailingListID, plan.Remove[start:end]); err != nil {
+			return plan, err
+		}
+	}
+
+	return plan, nil
+}
+
+// variablesChanged reports whether any of the desired variables differs from the current one
+func variablesChanged(current, desired map[string]interface{}) bool {
+	for name, value := range desired {
+		currentValue, ok := current[name]
+		if !ok || fmt.Sprint(currentValue) != fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// variablesFromMap converts variables map to a sorted list of variables
+func variablesFromMap(variables map[string]interface{}) []*Variable {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]*Variable, len(names))
+	for i, name := range names {
+		result[i] = &Variable{Name: name, Value: variables[name]}
+	}
+	return result
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,128 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (suite *SendpulseTestSuite) mockMailingListForSync(added, removed *[]string, updated *[]string) {
+	suite.mux.HandleFunc("/addressbooks/1/emails", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("offset") != "0" {
+				fmt.Fprintf(w, `[]`)
+				return
+			}
+			fmt.Fprintf(w, `[
+				{"email": "keep@test.com", "status": 1, "variables": {"name": "Alex"}},
+				{"email": "Change@test.com", "status": 1, "variables": {"name": "Bob"}},
+				{"email": "gone@test.com", "status": 1, "variables": {}}
+			]`)
+		case http.MethodPost:
+			var body struct {
+				Emails []*EmailToAdd `json:"emails"`
+			}
+			suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+			for _, email := range body.Emails {
+				*added = append(*added, email.Email)
+			}
+			fmt.Fprintf(w, `{"result": true}`)
+		case http.MethodDelete:
+			var body struct {
+				Emails []string `json:"emails"`
+			}
+			suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+			*removed = append(*removed, body.Emails...)
+			fmt.Fprintf(w, `{"result": true}`)
+		}
+	})
+	suite.mux.HandleFunc("/addressbooks/1/emails/variable", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		var body struct {
+			Email string `json:"email"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		*updated = append(*updated, body.Email)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+}
+
+func syncDesiredEmails() []*EmailToAdd {
+	return []*EmailToAdd{
+		{Email: "keep@test.com", Variables: map[string]interface{}{"name": "Alex"}},
+		{Email: "change@test.com", Variables: map[string]interface{}{"name": "Robert"}},
+		{Email: "new@test.com", Variables: map[string]interface{}{"name": "Carl"}},
+	}
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_AddressBooksService_Sync() {
+	var added, removed, updated []string
+	suite.mockMailingListForSync(&added, &removed, &updated)
+
+	plan, err := suite.client.Emails.MailingLists.Sync(context.Background(), 1, MailingListSyncParams{
+		Emails:           syncDesiredEmails(),
+		PageSize:         3,
+		MaxDeletePercent: 50,
+	})
+	suite.NoError(err)
+	suite.Equal(3, plan.CurrentQty)
+	suite.Equal(1, plan.UnchangedQty)
+	suite.Equal([]string{"new@test.com"}, added)
+	suite.Equal([]string{"change@test.com"}, updated)
+	suite.Equal([]string{"gone@test.com"}, removed)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_AddressBooksService_SyncDryRun() {
+	var added, removed, updated []string
+	suite.mockMailingListForSync(&added, &removed, &updated)
+
+	plan, err := suite.client.Emails.MailingLists.Sync(context.Background(), 1, MailingListSyncParams{
+		Emails:           syncDesiredEmails(),
+		MaxDeletePercent: 50,
+		DryRun:           true,
+	})
+	suite.NoError(err)
+	suite.False(plan.IsEmpty())
+	suite.Contains(plan.String(), "- gone@test.com")
+	suite.Empty(added)
+	suite.Empty(removed)
+	suite.Empty(updated)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_AddressBooksService_SyncThreshold() {
+	var added, removed, updated []string
+	suite.mockMailingListForSync(&added, &removed, &updated)
+
+	_, err := suite.client.Emails.MailingLists.Sync(context.Background(), 1, MailingListSyncParams{
+		Emails: syncDesiredEmails(),
+	})
+	suite.IsType(&SyncThresholdError{}, err)
+	suite.Equal(float64(defaultSyncMaxDeletePercent), err.(*SyncThresholdError).MaxDeletePercent)
+
+	_, err = suite.client.Emails.MailingLists.Sync(context.Background(), 1, MailingListSyncParams{
+		Emails:           syncDesiredEmails()[:1],
+		MaxDeletePercent: 50,
+	})
+	suite.IsType(&SyncThresholdError{}, err)
+	suite.Empty(added)
+	suite.Empty(removed)
+	suite.Empty(updated)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_AddressBooksService_SyncEmpty() {
+	var added, removed, updated []string
+	suite.mockMailingListForSync(&added, &removed, &updated)
+
+	_, err := suite.client.Emails.MailingLists.Sync(context.Background(), 1, MailingListSyncParams{MaxDeletePercent: 100})
+	suite.Error(err)
+	suite.Empty(removed)
+
+	_, err = suite.client.Emails.MailingLists.Sync(context.Background(), 1, MailingListSyncParams{
+		MaxDeletePercent: 100,
+		AllowEmpty:       true,
+	})
+	suite.NoError(err)
+	suite.Len(removed, 3)
+}
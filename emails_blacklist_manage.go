@@ -0,0 +1,221 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// BlacklistEntry describes an email address in the blacklist
+type BlacklistEntry struct {
+	Email   string       `json:"email"`
+	Comment string       `json:"comment"`
+	AddDate DateTimeType `json:"add_date"`
+}
+
+// UnmarshalJSON decodes an entry given either as an object or as a plain email string
+func (e *BlacklistEntry) UnmarshalJSON(data []byte) error {
+	var email string
+	if err := json.Unmarshal(data, &email); err == nil {
+		*e = BlacklistEntry{Email: email}
+		return nil
+	}
+	type entry BlacklistEntry
+	return json.Unmarshal(data, (*entry)(e))
+}
+
+// BlacklistEmail is an email address with a comment to add to the blacklist
+type BlacklistEmail struct {
+	Email   string
+	Comment string
+}
+
+// GetBlacklist returns a page of the blacklist with comments and dates
+func (service *BlacklistService) GetBlacklist(ctx context.Context, limit int, offset int) ([]*BlacklistEntry, error) {
+	path := fmt.Sprintf("/blacklist?limit=%d&offset=%d", limit, offset)
+	var entries []*BlacklistEntry
+	_, err := service.client.newRequest(ctx, http.MethodGet, path, nil, &entries, true)
+	return entries, err
+}
+
+// GetAllBlacklist returns the whole blacklist reading it page by page. It takes a request per pageSize addresses.
+// An error is returned if a page repeats the previous one, which means the API ignores the offset
+func (service *BlacklistService) GetAllBlacklist(ctx context.Context, pageSize int) ([]*BlacklistEntry, error) {
+	if pageSize <= 0 {
+		pageSize = defaultSyncPageSize
+	}
+	var entries, previous []*BlacklistEntry
+	for offset := 0; ; offset += pageSize {
+		page, err := service.GetBlacklist(ctx, pageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) != 0 && sameBlacklistPage(page, previous) {
+			return nil, fmt.Errorf("blacklist page at offset %d repeats the previous page", offset)
+		}
+		entries = append(entries, page...)
+		if len(page) < pageSize {
+			return entries, nil
+		}
+		previous = page
+	}
+}
+
+// BlacklistSet is a set of blacklisted emails to check many emails without requests
+type BlacklistSet map[string]bool
+
+// Contains reports whether the email is blacklisted
+func (s BlacklistSet) Contains(email string) bool {
+	return s[normalizeEmail(email)]
+}
+
+// GetBlacklistSet downloads the whole blacklist with GetAllBlacklist and returns it as a set
+func (service *BlacklistService) GetBlacklistSet(ctx context.Context) (BlacklistSet, error) {
+	blacklist, err := service.GetAllBlacklist(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	set := make(BlacklistSet, len(blacklist))
+	for _, entry := range blacklist {
+		set[normalizeEmail(entry.Email)] = true
+	}
+	return set, nil
+}
+
+// IsBlacklisted checks emails with the statistics of email addresses. It takes a request per 100 emails
+// and doesn't download the blacklist; use GetBlacklistSet to check many emails against the whole blacklist.
+// The result is keyed by the emails as they were passed
+func (service *BlacklistService) IsBlacklisted(ctx context.Context, emails []string) (map[string]bool, error) {
+	blacklisted := make(map[string]bool)
+	for start := 0; start < len(emails); start += defaultSyncBatchSize {
+		batch := emails[start:minInt(start+defaultSyncBatchSize, len(emails))]
+		statistics, err := service.client.Emails.Address.GetEmailsStatisticsByCampaignsAndAddressBooks(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		for email, statistic := range statistics {
+			if statistic != nil && statistic.Blacklist {
+				blacklisted[normalizeEmail(email)] = true
+			}
+		}
+	}
+
+	result := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		result[email] = blacklisted[normalizeEmail(email)]
+	}
+	return result, nil
+}
+
+func sameBlacklistPage(page, previous []*BlacklistEntry) bool {
+	if len(page) != len(previous) {
+		return false
+	}
+	for i := range page {
+		if page[i].Email != previous[i].Email {
+			return false
+		}
+	}
+	return true
+}
+
+// AddEmailsToBlacklist appends emails with individual comments to the blacklist.
+// Emails with the same comment are added with one request
+func (service *BlacklistService) AddEmailsToBlacklist(ctx context.Context, emails []*BlacklistEmail) error {
+	var comments []string
+	byComment := make(map[string][]string)
+	for _, email := range emails {
+		if _, ok := byComment[email.Comment]; !ok {
+			comments = append(comments, email.Comment)
+		}
+		byComment[email.Comment] = append(byComment[email.Comment], email.Email)
+	}
+
+	for _, comment := range comments {
+		if err := service.AddToBlacklist(ctx, byComment[comment], comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BlacklistSyncParams describes parameters of the blacklist sync
+type BlacklistSyncParams struct {
+	// Emails is the external suppression list
+	Emails []*BlacklistEmail
+	// RemoveMissing removes blacklisted emails which are missing in Emails, making the blacklist an exact mirror
+	RemoveMissing bool
+	// AllowEmpty allows RemoveMissing with empty Emails, which clears the whole blacklist
+	AllowEmpty bool
+	// BatchSize is the max number of emails per request (default: 100)
+	BatchSize int
+	// DryRun only computes the changes
+	DryRun bool
+}
+
+// BlacklistSyncResult represents changes made by the blacklist sync
+type BlacklistSyncResult struct {
+	Added   []string
+	Removed []string
+}
+
+// SyncBlacklist mirrors an external suppression list into the blacklist in batches.
+// RemoveMissing with empty Emails is refused unless AllowEmpty is set
+func (service *BlacklistService) SyncBlacklist(ctx context.Context, params BlacklistSyncParams) (*BlacklistSyncResult, error) {
+	if params.RemoveMissing && len(params.Emails) == 0 && !params.AllowEmpty {
+		return nil, fmt.Errorf("blacklist sync has no emails: set AllowEmpty to clear the blacklist")
+	}
+
+	current, err := service.GetAllBlacklist(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool, len(current))
+	for _, entry := range current {
+		existing[normalizeEmail(entry.Email)] = true
+	}
+
+	result := &BlacklistSyncResult{}
+	desired := make(map[string]bool, len(params.Emails))
+	var toAdd []*BlacklistEmail
+	for _, email := range params.Emails {
+		key := normalizeEmail(email.Email)
+		if key == "" || desired[key] {
+			continue
+		}
+		desired[key] = true
+		if !existing[key] {
+			toAdd = append(toAdd, email)
+			result.Added = append(result.Added, email.Email)
+		}
+	}
+	if params.RemoveMissing {
+		for _, entry := range current {
+			if !desired[normalizeEmail(entry.Email)] {
+				result.Removed = append(result.Removed, entry.Email)
+			}
+		}
+		sort.Strings(result.Removed)
+	}
+	if params.DryRun {
+		return result, nil
+	}
+
+	batchSize := params.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSyncBatchSize
+	}
+	for start := 0; start < len(toAdd); start += batchSize {
+		if err := service.AddEmailsToBlacklist(ctx, toAdd[start:minInt(start+batchSize, len(toAdd))]); err != nil {
+			return result, err
+		}
+	}
+	for start := 0; start < len(result.Removed); start += batchSize {
+		if err := service.RemoveFromBlacklist(ctx, result.Removed[start:minInt(start+batchSize, len(result.Removed))]); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,126 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+func (suite *SendpulseTestSuite) TestEmailsService_BlacklistService_GetBlacklist() {
+	suite.mux.HandleFunc("/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprintf(w, `[
+				{"email": "a@test.com", "comment": "bounced", "add_date": "2021-01-01 10:00:00"},
+				"b@test.com"
+			]`)
+		default:
+			fmt.Fprintf(w, `[{"email": "c@test.com"}]`)
+		}
+	})
+
+	entries, err := suite.client.Emails.Blacklist.GetAllBlacklist(context.Background(), 2)
+	suite.NoError(err)
+	suite.Len(entries, 3)
+	suite.Equal("bounced", entries[0].Comment)
+	suite.Equal(2021, entries[0].AddDate.Time().Year())
+	suite.Equal("b@test.com", entries[1].Email)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_BlacklistService_GetBlacklistRepeatedPage() {
+	suite.mux.HandleFunc("/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"email": "a@test.com"}, {"email": "b@test.com"}]`)
+	})
+
+	_, err := suite.client.Emails.Blacklist.GetAllBlacklist(context.Background(), 2)
+	suite.Error(err)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_BlacklistService_IsBlacklisted() {
+	suite.mux.HandleFunc("/emails/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		fmt.Fprintf(w, `{"a@test.com": {"sent": 1, "blacklist": true}, "c@test.com": {"sent": 1, "blacklist": false}}`)
+	})
+
+	result, err := suite.client.Emails.Blacklist.IsBlacklisted(context.Background(), []string{"A@test.com", "c@test.com"})
+	suite.NoError(err)
+	suite.Equal(map[string]bool{"A@test.com": true, "c@test.com": false}, result)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_BlacklistService_GetBlacklistSet() {
+	suite.mux.HandleFunc("/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("0", r.URL.Query().Get("offset"))
+		fmt.Fprintf(w, `[{"email": "a@test.com"}, {"email": "b@test.com"}]`)
+	})
+
+	set, err := suite.client.Emails.Blacklist.GetBlacklistSet(context.Background())
+	suite.NoError(err)
+	suite.True(set.Contains("A@test.com"))
+	suite.False(set.Contains("c@test.com"))
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_BlacklistService_Sync() {
+	added := make(map[string][]string)
+	var removed []string
+	suite.mux.HandleFunc("/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Emails  string `json:"emails"`
+			Comment string `json:"comment"`
+		}
+		switch r.Method {
+		case http.MethodGet:
+			suite.NotEmpty(r.URL.Query().Get("limit"))
+			fmt.Fprintf(w, `[{"email": "old@test.com"}, {"email": "kept@test.com"}]`)
+			return
+		case http.MethodPost:
+			suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+			emails, err := b64.StdEncoding.DecodeString(body.Emails)
+			suite.NoError(err)
+			added[body.Comment] = append(added[body.Comment], strings.Split(string(emails), ",")...)
+		case http.MethodDelete:
+			suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+			emails, err := b64.StdEncoding.DecodeString(body.Emails)
+			suite.NoError(err)
+			removed = append(removed, strings.Split(string(emails), ",")...)
+		}
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+
+	params := BlacklistSyncParams{
+		Emails: []*BlacklistEmail{
+			{Email: "KEPT@test.com", Comment: "bounce"},
+			{Email: "new1@test.com", Comment: "bounce"},
+			{Email: "new2@test.com", Comment: "complaint"},
+			{Email: "new3@test.com", Comment: "bounce"},
+		},
+		RemoveMissing: true,
+		BatchSize:     2,
+		DryRun:        true,
+	}
+	result, err := suite.client.Emails.Blacklist.SyncBlacklist(context.Background(), params)
+	suite.NoError(err)
+	suite.Equal([]string{"new1@test.com", "new2@test.com", "new3@test.com"}, result.Added)
+	suite.Equal([]string{"old@test.com"}, result.Removed)
+	suite.Empty(added)
+
+	params.DryRun = false
+	_, err = suite.client.Emails.Blacklist.SyncBlacklist(context.Background(), params)
+	suite.NoError(err)
+	sort.Strings(added["bounce"])
+	suite.Equal([]string{"new1@test.com", "new3@test.com"}, added["bounce"])
+	suite.Equal([]string{"new2@test.com"}, added["complaint"])
+	suite.Equal([]string{"old@test.com"}, removed)
+
+	removed = nil
+	_, err = suite.client.Emails.Blacklist.SyncBlacklist(context.Background(), BlacklistSyncParams{RemoveMissing: true})
+	suite.Error(err)
+	suite.Empty(removed)
+
+	result, err = suite.client.Emails.Blacklist.SyncBlacklist(context.Background(), BlacklistSyncParams{RemoveMissing: true, AllowEmpty: true, DryRun: true})
+	suite.NoError(err)
+	suite.Equal([]string{"kept@test.com", "old@test.com"}, result.Removed)
+}
@@ -0,0 +1,389 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Names of campaign pre-flight checks
+const (
+	PreflightCheckContent     = "content"
+	PreflightCheckRecipients  = "recipients"
+	PreflightCheckSubject     = "subject"
+	PreflightCheckSendDate    = "send_date"
+	PreflightCheckSender      = "sender"
+	PreflightCheckMailingList = "mailing_list"
+	PreflightCheckCost        = "cost"
+	PreflightCheckBalance     = "balance"
+	PreflightCheckDomainAuth  = "domain_auth"
+)
+
+// PreflightCheck represents a result of a single pre-flight check
+type PreflightCheck struct {
+	Name    string
+	Passed  bool
+	Skipped bool // The check can't be performed for the params and doesn't fail the report
+	Message string
+	// Hint describes how to fix a failed check
+	Hint string
+}
+
+// PreflightChecks is a list of check results shared by campaign and domain reports
+type PreflightChecks []*PreflightCheck
+
+// OK reports whether all checks passed
+func (c PreflightChecks) OK() bool {
+	return len(c.Failed()) == 0
+}
+
+// Failed returns failed checks
+func (c PreflightChecks) Failed() []*PreflightCheck {
+	var failed []*PreflightCheck
+	for _, check := range c {
+		if !check.Passed && !check.Skipped {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// Check returns a check by its name or nil if the check wasn't performed
+func (c PreflightChecks) Check(name string) *PreflightCheck {
+	for _, check := range c {
+		if check.Name == name {
+			return check
+		}
+	}
+	return nil
+}
+
+// String returns a human-readable report
+func (c PreflightChecks) String() string {
+	var sb strings.Builder
+	for _, check := range c {
+		mark := "ok"
+		switch {
+		case check.Skipped:
+			mark = "skip"
+		case !check.Passed:
+			mark = "FAIL"
+		}
+		fmt.Fprintf(&sb, "[%s] %s", mark, check.Name)
+		if check.Message != "" {
+			fmt.Fprintf(&sb, ": %s", check.Message)
+		}
+		sb.WriteString("\n")
+		if check.Hint != "" {
+			fmt.Fprintf(&sb, "    fix: %s\n", check.Hint)
+		}
+	}
+	return sb.String()
+}
+
+func (c *PreflightChecks) add(name string, passed bool, format string, args ...interface{}) {
+	*c = append(*c, &PreflightCheck{Name: name, Passed: passed, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *PreflightChecks) skip(name string, format string, args ...interface{}) {
+	*c = append(*c, &PreflightCheck{Name: name, Skipped: true, Message: fmt.Sprintf(format, args...)})
+}
+
+// CampaignPreflightReport represents results of campaign pre-flight checks
+type CampaignPreflightReport struct {
+	PreflightChecks
+	Params      CampaignParams
+	Sender      *Sender
+	MailingList *MailingList
+	Cost        *CampaignCost
+	Balance     *Balance
+	// DomainAuth is set if the builder has a DomainAuthChecker
+	DomainAuth *DomainAuthReport
+}
+
+// CampaignPreflightError is returned when a campaign doesn't pass pre-flight checks
+type CampaignPreflightError struct {
+	Report *CampaignPreflightReport
+}
+
+// Error returns string representation of the CampaignPreflightError
+func (e *CampaignPreflightError) Error() string {
+	names := make([]string, 0, len(e.Report.PreflightChecks))
+	for _, check := range e.Report.Failed() {
+		names = append(names, check.Name)
+	}
+	return fmt.Sprintf("campaign pre-flight checks failed: %s", strings.Join(names, ", "))
+}
+
+// CampaignBuilder builds campaign params and validates them before the campaign is created
+type CampaignBuilder struct {
+	service    *CampaignsService
+	params     CampaignParams
+	domainAuth *DomainAuthChecker
+	now        func() time.Time
+}
+
+// NewCampaignBuilder creates CampaignBuilder
+func (service *CampaignsService) NewCampaignBuilder() *CampaignBuilder {
+	return &CampaignBuilder{service: service, now: time.Now}
+}
+
+// Name sets the campaign name
+func (b *CampaignBuilder) Name(name string) *CampaignBuilder {
+	b.params.Name = name
+	return b
+}
+
+// Sender sets the sender of the campaign
+func (b *CampaignBuilder) Sender(name, email string) *CampaignBuilder {
+	b.params.SenderName = name
+	b.params.SenderEmail = email
+	return b
+}
+
+// Subject sets the subject of the campaign
+func (b *CampaignBuilder) Subject(subject string) *CampaignBuilder {
+	b.params.Subject = subject
+	return b
+}
+
+// Body sets the HTML body of the campaign
+func (b *CampaignBuilder) Body(body string) *CampaignBuilder {
+	b.params.Body = body
+	return b
+}
+
+// Template sets the template of the campaign
+func (b *CampaignBuilder) Template(templateID string) *CampaignBuilder {
+	b.params.TemplateID = templateID
+	return b
+}
+
+// MailingList sets the mailing list of the campaign
+func (b *CampaignBuilder) MailingList(mailingListID int) *CampaignBuilder {
+	b.params.MailingListID = mailingListID
+	return b
+}
+
+// Segment sets the segment of the campaign
+func (b *CampaignBuilder) Segment(segmentID int) *CampaignBuilder {
+	b.params.SegmentID = segmentID
+	return b
+}
+
+// SendAt schedules the campaign
+func (b *CampaignBuilder) SendAt(date time.Time) *CampaignBuilder {
+	b.params.SendDate = DateTimeType(date)
+	return b
+}
+
+// Test marks the campaign as a test one
+func (b *CampaignBuilder) Test(isTest bool) *CampaignBuilder {
+	b.params.IsTest = isTest
+	return b
+}
+
+// DomainAuth makes Preflight check SPF, DKIM and DMARC records of the sender domain with the checker
+func (b *CampaignBuilder) DomainAuth(checker *DomainAuthChecker) *CampaignBuilder {
+	b.domainAuth = checker
+	return b
+}
+
+// Attachments adds attachments of the composer to the campaign
+func (b *CampaignBuilder) Attachments(composer *EmailComposer) error {
+	return composer.ApplyToCampaign(&b.params)
+}
+
+// Params returns the built campaign params
+func (b *CampaignBuilder) Params() CampaignParams {
+	return b.params
+}
+
+// Validate performs local checks of the campaign params without API requests
+func (b *CampaignBuilder) Validate() *CampaignPreflightReport {
+	report := &CampaignPreflightReport{Params: b.params}
+	p := b.params
+
+	switch {
+	case p.Body != "" && p.TemplateID != "":
+		report.add(PreflightCheckContent, false, "body and template are mutually exclusive")
+	case p.Body == "" && p.TemplateID == "":
+		report.add(PreflightCheckContent, false, "body or template is required")
+	default:
+		report.add(PreflightCheckContent, true, "")
+	}
+
+	switch {
+	case p.MailingListID != 0 && p.SegmentID != 0:
+		report.add(PreflightCheckRecipients, false, "mailing list and segment are mutually exclusive")
+	case p.MailingListID == 0 && p.SegmentID == 0:
+		report.add(PreflightCheckRecipients, false, "mailing list or segment is required")
+	default:
+		report.add(PreflightCheckRecipients, true, "")
+	}
+
+	if strings.TrimSpace(p.Subject) == "" {
+		report.add(PreflightCheckSubject, false, "subject is required")
+	} else {
+		report.add(PreflightCheckSubject, true, "")
+	}
+
+	if !p.SendDate.IsZero() {
+		if p.SendDate.Time().Before(b.now()) {
+			report.add(PreflightCheckSendDate, false, "send date %s is in the past", p.SendDate.Format())
+		} else {
+			report.add(PreflightCheckSendDate, true, "")
+		}
+	}
+	return report
+}
+
+// Preflight performs local checks and checks the sender, the mailing list, the cost and the balance.
+// The sender domain is checked too if DomainAuth is set.
+// The API calculates the cost for mailing lists only, so these checks are reported as skipped for segments.
+// The balance is requested in the currency of the cost, the balance check is skipped if the currencies differ
+func (b *CampaignBuilder) Preflight(ctx context.Context) (*CampaignPreflightReport, error) {
+	report := b.Validate()
+	emails := b.service.client.Emails
+
+	if err := b.checkSender(ctx, report, emails.Senders); err != nil {
+		return report, err
+	}
+	if err := b.checkDomainAuth(ctx, report); err != nil {
+		return report, err
+	}
+
+	if b.params.MailingListID == 0 {
+		if b.params.SegmentID != 0 {
+			for _, name := range []string{PreflightCheckMailingList, PreflightCheckCost, PreflightCheckBalance} {
+				report.skip(name, "not available for segment %d", b.params.SegmentID)
+			}
+		}
+		return report, nil
+	}
+	listOK, err := b.checkMailingList(ctx, report, emails.MailingLists)
+	if err != nil || !listOK {
+		return report, err
+	}
+
+	report.Cost, err = emails.MailingLists.CountCampaignCost(ctx, b.params.MailingListID)
+	if err != nil {
+		return report, err
+	}
+	if !report.Cost.Result {
+		report.add(PreflightCheckCost, false, "cost of the campaign can't be calculated")
+		return report, nil
+	}
+	report.add(PreflightCheckCost, true, "%d emails, overdraft price %d", report.Cost.SentEmailsQty, report.Cost.OverdraftAllEmailsPrice)
+
+	if report.Cost.Cur == "" {
+		report.skip(PreflightCheckBalance, "currency of the cost is unknown")
+		return report, nil
+	}
+	report.Balance, err = b.service.client.Balance.GetBalance(ctx, report.Cost.Cur)
+	if err != nil {
+		return report, err
+	}
+	if !strings.EqualFold(report.Balance.Currency, report.Cost.Cur) {
+		report.skip(PreflightCheckBalance, "balance is in %s, the cost is in %s", report.Balance.Currency, report.Cost.Cur)
+		return report, nil
+	}
+	price := float32(report.Cost.OverdraftAllEmailsPrice)
+	if price > report.Balance.BalanceCurrency {
+		report.add(PreflightCheckBalance, false, "balance %.2f %s is less than overdraft price %.2f",
+			report.Balance.BalanceCurrency, report.Balance.Currency, price)
+	} else {
+		report.add(PreflightCheckBalance, true, "")
+	}
+	return report, nil
+}
+
+// Create creates the campaign if all pre-flight checks pass. CampaignPreflightError is returned otherwise.
+// Please note that you can send a maximum of 4 campaigns per hour
+func (b *CampaignBuilder) Create(ctx context.Context) (*Campaign, *CampaignPreflightReport, error) {
+	report, err := b.Preflight(ctx)
+	if err != nil {
+		return nil, report, err
+	}
+	if !report.OK() {
+		return nil, report, &CampaignPreflightError{Report: report}
+	}
+	campaign, err := b.service.CreateCampaign(ctx, b.params)
+	return campaign, report, err
+}
+
+func (b *CampaignBuilder) checkSender(ctx context.Context, report *CampaignPreflightReport, service *SendersService) error {
+	if b.params.SenderEmail == "" {
+		report.add(PreflightCheckSender, false, "sender email is required")
+		return nil
+	}
+	senders, err := service.GetSenders(ctx)
+	if err != nil {
+		return err
+	}
+	report.Sender = findSender(senders, b.params.SenderEmail)
+	switch sender := report.Sender; {
+	case sender == nil:
+		report.add(PreflightCheckSender, false, "sender %s is not found", b.params.SenderEmail)
+	case !isActiveSender(sender):
+		report.add(PreflightCheckSender, false, "sender %s is not active: %s", sender.Email, sender.Status)
+	default:
+		report.add(PreflightCheckSender, true, "")
+	}
+	return nil
+}
+
+func (b *CampaignBuilder) checkDomainAuth(ctx context.Context, report *CampaignPreflightReport) error {
+	if b.domainAuth == nil || b.params.SenderEmail == "" {
+		return nil
+	}
+	domainReport, err := b.domainAuth.CheckSender(ctx, b.params.SenderEmail)
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		report.add(PreflightCheckDomainAuth, false, "%v", err)
+		return nil
+	}
+
+	report.DomainAuth = domainReport
+	failed := domainReport.Failed()
+	if len(failed) == 0 {
+		report.add(PreflightCheckDomainAuth, true, "")
+		return nil
+	}
+	names := make([]string, len(failed))
+	for i, check := range failed {
+		names[i] = check.Name
+	}
+	report.add(PreflightCheckDomainAuth, false, "%s of %s failed", strings.Join(names, ", "), domainReport.Domain)
+	return nil
+}
+
+func (b *CampaignBuilder) checkMailingList(ctx context.Context, report *CampaignPreflightReport, service *MailingListsService) (bool, error) {
+	list, err := service.GetMailingList(ctx, b.params.MailingListID)
+	var apiErr *SendpulseError
+	if errors.As(err, &apiErr) && (apiErr.HttpCode == http.StatusBadRequest || apiErr.HttpCode == http.StatusNotFound) {
+		list, err = nil, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	report.MailingList = list
+	switch {
+	case list == nil:
+		report.add(PreflightCheckMailingList, false, "mailing list %d is not found", b.params.MailingListID)
+	case !list.Status.IsActive():
+		report.add(PreflightCheckMailingList, false, "mailing list %d is not active: %s", list.ID, list.Status)
+	case list.ActiveEmailQty == 0:
+		report.add(PreflightCheckMailingList, false, "mailing list %d has no active emails", list.ID)
+	default:
+		report.add(PreflightCheckMailingList, true, "%d active emails", list.ActiveEmailQty)
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,170 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) handleCampaignPreflight(activeEmails int, balance float32) {
+	suite.mux.HandleFunc("/senders", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[
+			{"email": "active@sendpulse.com", "name": "Active", "status": "Active"},
+			{"email": "new@sendpulse.com", "name": "New", "status": "Requested activation"}
+		]`)
+	})
+	suite.mux.HandleFunc("/addressbooks/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"id": 1, "name": "Book", "all_email_qty": 10, "active_email_qty": %d, "status": 0}]`, activeEmails)
+	})
+	suite.mux.HandleFunc("/addressbooks/2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error_code": 213, "message": "Book not found"}`)
+	})
+	suite.mux.HandleFunc("/addressbooks/3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprintf(w, `{"error_code": 403, "message": "Forbidden"}`)
+	})
+	suite.mux.HandleFunc("/addressbooks/1/cost", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "cur": "USD", "sent_emails_qty": 10, "overdraft_all_emails_price": 5}`)
+	})
+	suite.mux.HandleFunc("/balance/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"currency": "%s", "balance_currency": %v}`, strings.ToUpper(strings.TrimPrefix(r.URL.Path, "/balance/")), balance)
+	})
+}
+
+func (suite *SendpulseTestSuite) TestCampaignBuilder_Validate() {
+	report := suite.client.Emails.Campaigns.NewCampaignBuilder().
+		Body("<h1>Hi</h1>").
+		Template("1").
+		MailingList(1).
+		Segment(2).
+		SendAt(time.Now().Add(-time.Hour)).
+		Validate()
+
+	suite.False(report.OK())
+	suite.False(report.Check(PreflightCheckContent).Passed)
+	suite.False(report.Check(PreflightCheckRecipients).Passed)
+	suite.False(report.Check(PreflightCheckSubject).Passed)
+	suite.False(report.Check(PreflightCheckSendDate).Passed)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignBuilder_Create() {
+	suite.handleCampaignPreflight(10, 100)
+	suite.mux.HandleFunc("/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		fmt.Fprintf(w, `{"id": 5, "name": "News", "status": 13}`)
+	})
+
+	campaign, report, err := suite.client.Emails.Campaigns.NewCampaignBuilder().
+		Name("News").
+		Sender("Active", "active@sendpulse.com").
+		Subject("News").
+		Body("<h1>News</h1>").
+		MailingList(1).
+		Create(context.Background())
+	suite.NoError(err)
+	suite.True(report.OK(), report.String())
+	suite.Equal(5, campaign.ID)
+	suite.Equal(10, report.Cost.SentEmailsQty)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignBuilder_PreflightFailed() {
+	suite.handleCampaignPreflight(0, 1)
+
+	builder := suite.client.Emails.Campaigns.NewCampaignBuilder().
+		Sender("New", "new@sendpulse.com").
+		Subject("News").
+		Template("1").
+		MailingList(1)
+	_, report, err := builder.Create(context.Background())
+	suite.IsType(&CampaignPreflightError{}, err)
+	suite.False(report.Check(PreflightCheckSender).Passed)
+	suite.False(report.Check(PreflightCheckMailingList).Passed)
+	suite.Nil(report.Check(PreflightCheckCost))
+
+	report, err = builder.MailingList(2).Preflight(context.Background())
+	suite.NoError(err)
+	suite.Contains(report.Check(PreflightCheckMailingList).Message, "not found")
+
+	_, err = builder.MailingList(3).Preflight(context.Background())
+	suite.Error(err)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignBuilder_PreflightSegment() {
+	suite.handleCampaignPreflight(10, 100)
+
+	report, err := suite.client.Emails.Campaigns.NewCampaignBuilder().
+		Sender("Active", "active@sendpulse.com").
+		Subject("News").
+		Body("<h1>News</h1>").
+		Segment(7).
+		Preflight(context.Background())
+	suite.NoError(err)
+	suite.True(report.OK(), report.String())
+	suite.True(report.Check(PreflightCheckCost).Skipped)
+	suite.True(report.Check(PreflightCheckBalance).Skipped)
+	suite.Contains(report.String(), "[skip] cost")
+}
+
+func (suite *SendpulseTestSuite) TestCampaignBuilder_InsufficientBalance() {
+	suite.handleCampaignPreflight(10, 1)
+
+	report, err := suite.client.Emails.Campaigns.NewCampaignBuilder().
+		Sender("Active", "active@sendpulse.com").
+		Subject("News").
+		Body("<h1>News</h1>").
+		MailingList(1).
+		Preflight(context.Background())
+	suite.NoError(err)
+	suite.True(report.Check(PreflightCheckCost).Passed)
+	suite.False(report.Check(PreflightCheckBalance).Passed)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignBuilder_BalanceCurrency() {
+	suite.mux.HandleFunc("/senders", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"email": "active@sendpulse.com", "name": "Active", "status": "Active"}]`)
+	})
+	suite.mux.HandleFunc("/addressbooks/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"id": 1, "name": "Book", "all_email_qty": 10, "active_email_qty": 10, "status": 0}]`)
+	})
+	suite.mux.HandleFunc("/addressbooks/1/cost", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "cur": "EUR", "sent_emails_qty": 10, "overdraft_all_emails_price": 5}`)
+	})
+	suite.mux.HandleFunc("/balance/eur", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"currency": "USD", "balance_currency": 1}`)
+	})
+
+	report, err := suite.client.Emails.Campaigns.NewCampaignBuilder().
+		Sender("Active", "active@sendpulse.com").
+		Subject("News").
+		Body("<h1>News</h1>").
+		MailingList(1).
+		Preflight(context.Background())
+	suite.NoError(err)
+	suite.True(report.OK(), report.String())
+	suite.True(report.Check(PreflightCheckBalance).Skipped)
+	suite.Equal("balance is in USD, the cost is in EUR", report.Check(PreflightCheckBalance).Message)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignBuilder_PreflightDomainAuth() {
+	suite.handleCampaignPreflight(10, 100)
+	checker := NewDomainAuthChecker(fakeDnsResolver{
+		"sendpulse.com": {"v=spf1 include:mxsmtp.sendpulse.com ~all"},
+	})
+
+	report, err := suite.client.Emails.Campaigns.NewCampaignBuilder().
+		Sender("Active", "active@sendpulse.com").
+		Subject("News").
+		Body("<h1>News</h1>").
+		MailingList(1).
+		DomainAuth(checker).
+		Preflight(context.Background())
+	suite.NoError(err)
+	suite.False(report.OK())
+	suite.Equal("dkim, dmarc of sendpulse.com failed", report.Check(PreflightCheckDomainAuth).Message)
+	suite.True(report.DomainAuth.Check(DomainAuthCheckSPF).Passed)
+	suite.Contains(report.DomainAuth.String(), "fix: publish TXT record")
+	suite.True(report.Check(PreflightCheckBalance).Passed)
+}
@@ -0,0 +1,503 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// CampaignsPerHour is the max number of campaigns which can be created per hour
+const CampaignsPerHour = 4
+
+// QueuedCampaignState is a state of a campaign in the send queue
+type QueuedCampaignState string
+
+const (
+	QueuedCampaignPending   QueuedCampaignState = "pending"
+	QueuedCampaignSubmitted QueuedCampaignState = "submitted"
+	QueuedCampaignFailed    QueuedCampaignState = "failed"
+	QueuedCampaignCanceled  QueuedCampaignState = "canceled"
+)
+
+// QueuedCampaign represents a campaign request in the send queue
+type QueuedCampaign struct {
+	ID          string              `json:"id"`
+	Params      CampaignParams      `json:"params"`
+	State       QueuedCampaignState `json:"state"`
+	CampaignID  int                 `json:"campaign_id,omitempty"`
+	Status      CampaignStatus      `json:"status"`
+	Error       string              `json:"error,omitempty"`
+	EnqueuedAt  time.Time           `json:"enqueued_at"`
+	SubmittedAt time.Time           `json:"submitted_at,omitempty"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// IsDone reports whether the queued campaign won't change anymore
+func (c *QueuedCampaign) IsDone() bool {
+	switch c.State {
+	case QueuedCampaignFailed, QueuedCampaignCanceled:
+		return true
+	case QueuedCampaignSubmitted:
+		return c.Status.IsFinal()
+	}
+	return false
+}
+
+// CampaignQueueStore persists queued campaigns. Save replaces a campaign with the same ID
+type CampaignQueueStore interface {
+	Save(ctx context.Context, campaign *QueuedCampaign) error
+	List(ctx context.Context) ([]*QueuedCampaign, error)
+}
+
+// MemoryCampaignQueueStore is an in-memory CampaignQueueStore
+type MemoryCampaignQueueStore struct {
+	lock      sync.RWMutex
+	campaigns map[string]*QueuedCampaign
+}
+
+// NewMemoryCampaignQueueStore creates MemoryCampaignQueueStore
+func NewMemoryCampaignQueueStore() *MemoryCampaignQueueStore {
+	return &MemoryCampaignQueueStore{campaigns: make(map[string]*QueuedCampaign)}
+}
+
+// Save stores a copy of the queued campaign
+func (s *MemoryCampaignQueueStore) Save(ctx context.Context, campaign *QueuedCampaign) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	stored := *campaign
+	s.campaigns[campaign.ID] = &stored
+	return nil
+}
+
+// List returns copies of all queued campaigns ordered by enqueue time
+func (s *MemoryCampaignQueueStore) List(ctx context.Context) ([]*QueuedCampaign, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	campaigns := make([]*QueuedCampaign, 0, len(s.campaigns))
+	for _, campaign := range s.campaigns {
+		stored := *campaign
+		campaigns = append(campaigns, &stored)
+	}
+	sortQueuedCampaigns(campaigns)
+	return campaigns, nil
+}
+
+// FileCampaignQueueStore is a CampaignQueueStore keeping queued campaigns in a JSON file
+type FileCampaignQueueStore struct {
+	lock sync.Mutex
+	path string
+}
+
+// NewFileCampaignQueueStore creates FileCampaignQueueStore. The file is created on the first save
+func NewFileCampaignQueueStore(path string) *FileCampaignQueueStore {
+	return &FileCampaignQueueStore{path: path}
+}
+
+// Save writes the queued campaign to the file
+func (s *FileCampaignQueueStore) Save(ctx context.Context, campaign *QueuedCampaign) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	campaigns, err := s.read()
+	if err != nil {
+		return err
+	}
+	campaigns[campaign.ID] = campaign
+
+	content, err := json.MarshalIndent(campaigns, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// List reads all queued campaigns from the file ordered by enqueue time
+func (s *FileCampaignQueueStore) List(ctx context.Context) ([]*QueuedCampaign, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	stored, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	campaigns := make([]*QueuedCampaign, 0, len(stored))
+	for _, campaign := range stored {
+		campaigns = append(campaigns, campaign)
+	}
+	sortQueuedCampaigns(campaigns)
+	return campaigns, nil
+}
+
+func (s *FileCampaignQueueStore) read() (map[string]*QueuedCampaign, error) {
+	campaigns := make(map[string]*QueuedCampaign)
+	content, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return campaigns, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, &campaigns); err != nil {
+		return nil, fmt.Errorf("invalid campaign queue file %s: %w", s.path, err)
+	}
+	return campaigns, nil
+}
+
+// CampaignQueue submits queued campaigns respecting the limit of campaigns per hour
+type CampaignQueue struct {
+	service *CampaignsService
+	store   CampaignQueueStore
+	// lock guards busy and state changes. It isn't held during API requests
+	lock sync.Mutex
+	// busy holds IDs of campaigns with API requests in progress
+	busy map[string]bool
+	// Limit is the max number of campaigns submitted within Period (default: CampaignsPerHour)
+	Limit int
+	// Period is the quota period (default: 1 hour)
+	Period time.Duration
+	// OnChange is called when a queued campaign changes its state or status
+	OnChange func(campaign *QueuedCampaign)
+	now      func() time.Time
+}
+
+// NewCampaignQueue creates CampaignQueue
+func NewCampaignQueue(service *CampaignsService, store CampaignQueueStore) *CampaignQueue {
+	if store == nil {
+		store = NewMemoryCampaignQueueStore()
+	}
+	return &CampaignQueue{
+		service: service,
+		store:   store,
+		busy:    make(map[string]bool),
+		Limit:   CampaignsPerHour,
+		Period:  time.Hour,
+		now:     time.Now,
+	}
+}
+
+// Enqueue adds a campaign request to the queue
+func (q *CampaignQueue) Enqueue(ctx context.Context, params CampaignParams) (*QueuedCampaign, error) {
+	id, err := newQueueID()
+	if err != nil {
+		return nil, err
+	}
+	// the send date is stored without a timezone, so it's kept in UTC to be read back as the same instant
+	if !params.SendDate.IsZero() {
+		params.SendDate = NewDateTime(params.SendDate.Time().UTC())
+	}
+	now := q.now()
+	campaign := &QueuedCampaign{
+		ID:         id,
+		Params:     params,
+		State:      QueuedCampaignPending,
+		EnqueuedAt: now,
+		UpdatedAt:  now,
+	}
+	if err := q.store.Save(ctx, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// Get returns a queued campaign or nil if it isn't found
+func (q *CampaignQueue) Get(ctx context.Context, id string) (*QueuedCampaign, error) {
+	campaigns, err := q.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, campaign := range campaigns {
+		if campaign.ID == id {
+			return campaign, nil
+		}
+	}
+	return nil, nil
+}
+
+// List returns all queued campaigns
+func (q *CampaignQueue) List(ctx context.Context) ([]*QueuedCampaign, error) {
+	return q.store.List(ctx)
+}
+
+// Process submits pending campaigns within the available quota and returns submitted ones.
+// Campaigns rejected by the API as invalid are marked as failed. On rate limiting, server or network errors
+// the campaign stays pending with Error set and the rest of the queue waits for the next Process
+func (q *CampaignQueue) Process(ctx context.Context) ([]*QueuedCampaign, error) {
+	var submitted []*QueuedCampaign
+	for {
+		campaign, err := q.reservePending(ctx)
+		if err != nil || campaign == nil {
+			return submitted, err
+		}
+
+		created, err := q.service.CreateCampaign(ctx, campaign.Params)
+		var apiErr *SendpulseError
+		if err != nil && !errors.As(err, &apiErr) {
+			q.release(campaign.ID)
+			return submitted, err
+		}
+
+		campaign.UpdatedAt = q.now()
+		retry := false
+		switch {
+		case err == nil:
+			campaign.State = QueuedCampaignSubmitted
+			campaign.SubmittedAt = campaign.UpdatedAt
+			campaign.CampaignID = created.ID
+			campaign.Status = created.Status
+			campaign.Error = ""
+			submitted = append(submitted, campaign)
+		case isRejectedCampaign(apiErr):
+			campaign.State = QueuedCampaignFailed
+			campaign.Error = err.Error()
+		default:
+			campaign.Error = err.Error()
+			retry = true
+		}
+		if err := q.saveReserved(ctx, campaign); err != nil {
+			return submitted, err
+		}
+		if retry {
+			return submitted, nil
+		}
+	}
+}
+
+// reservePending returns the next pending campaign if the quota allows submitting it and marks it as busy
+func (q *CampaignQueue) reservePending(ctx context.Context) (*QueuedCampaign, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	campaigns, err := q.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// pending busy campaigns are being submitted and take the quota as well
+	available := q.limit() - q.used(campaigns)
+	var next *QueuedCampaign
+	for _, campaign := range campaigns {
+		if campaign.State != QueuedCampaignPending {
+			continue
+		}
+		if q.busy[campaign.ID] {
+			available--
+		} else if next == nil {
+			next = campaign
+		}
+	}
+	if next == nil || available <= 0 {
+		return nil, nil
+	}
+	q.busy[next.ID] = true
+	return next, nil
+}
+
+// Refresh updates statuses of submitted campaigns which aren't finished yet
+func (q *CampaignQueue) Refresh(ctx context.Context) error {
+	campaigns, err := q.store.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, campaign := range campaigns {
+		if campaign.State != QueuedCampaignSubmitted || campaign.Status.IsFinal() {
+			continue
+		}
+		info, err := q.service.GetCampaign(ctx, campaign.CampaignID)
+		if err != nil {
+			return err
+		}
+		if info.Status == campaign.Status {
+			continue
+		}
+		if err := q.updateStatus(ctx, campaign.ID, info.Status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStatus sets the status of a campaign which is still submitted and not busy
+func (q *CampaignQueue) updateStatus(ctx context.Context, id string, status CampaignStatus) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	campaign, err := q.Get(ctx, id)
+	if err != nil || campaign == nil || campaign.State != QueuedCampaignSubmitted || q.busy[id] {
+		return err
+	}
+	campaign.Status = status
+	campaign.UpdatedAt = q.now()
+	return q.save(ctx, campaign)
+}
+
+// Cancel removes a pending campaign from the queue or cancels a submitted campaign via the API
+func (q *CampaignQueue) Cancel(ctx context.Context, id string) error {
+	q.lock.Lock()
+	campaign, err := q.Get(ctx, id)
+	if err == nil {
+		err = q.checkCancel(id, campaign)
+	}
+	if err != nil {
+		q.lock.Unlock()
+		return err
+	}
+	if campaign.State == QueuedCampaignPending {
+		defer q.lock.Unlock()
+		campaign.State = QueuedCampaignCanceled
+		campaign.UpdatedAt = q.now()
+		return q.save(ctx, campaign)
+	}
+	q.busy[id] = true
+	q.lock.Unlock()
+
+	if err := q.service.CancelCampaign(ctx, campaign.CampaignID); err != nil {
+		q.release(id)
+		return err
+	}
+	campaign.State = QueuedCampaignCanceled
+	campaign.Status = CampaignStatusCanceled
+	campaign.UpdatedAt = q.now()
+	return q.saveReserved(ctx, campaign)
+}
+
+func (q *CampaignQueue) checkCancel(id string, campaign *QueuedCampaign) error {
+	switch {
+	case campaign == nil:
+		return fmt.Errorf("queued campaign %s is not found", id)
+	case q.busy[id]:
+		return fmt.Errorf("queued campaign %s is being processed", id)
+	case campaign.State == QueuedCampaignSubmitted && campaign.Status.IsFinal():
+		return fmt.Errorf("campaign %d is already %s", campaign.CampaignID, campaign.Status)
+	case campaign.State != QueuedCampaignPending && campaign.State != QueuedCampaignSubmitted:
+		return fmt.Errorf("queued campaign %s is already %s", id, campaign.State)
+	}
+	return nil
+}
+
+// NextRelease returns the time when the next pending campaign can be submitted
+func (q *CampaignQueue) NextRelease(ctx context.Context) (time.Time, error) {
+	campaigns, err := q.store.List(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := q.now()
+	var submitted []time.Time
+	for _, campaign := range campaigns {
+		if !campaign.SubmittedAt.IsZero() && now.Sub(campaign.SubmittedAt) < q.period() {
+			submitted = append(submitted, campaign.SubmittedAt)
+		}
+	}
+	if len(submitted) < q.limit() {
+		return now, nil
+	}
+	sort.Slice(submitted, func(i, j int) bool {
+		return submitted[i].Before(submitted[j])
+	})
+	return submitted[len(submitted)-q.limit()].Add(q.period()), nil
+}
+
+// Run processes the queue and refreshes statuses with the interval until the context is done
+func (q *CampaignQueue) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := q.Process(ctx); err != nil {
+			return err
+		}
+		if err := q.Refresh(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// saveReserved saves a busy campaign and releases it
+func (q *CampaignQueue) saveReserved(ctx context.Context, campaign *QueuedCampaign) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	delete(q.busy, campaign.ID)
+	return q.save(ctx, campaign)
+}
+
+func (q *CampaignQueue) release(id string) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	delete(q.busy, id)
+}
+
+func (q *CampaignQueue) save(ctx context.Context, campaign *QueuedCampaign) error {
+	if err := q.store.Save(ctx, campaign); err != nil {
+		return err
+	}
+	if q.OnChange != nil {
+		q.OnChange(campaign)
+	}
+	return nil
+}
+
+// used returns the number of campaigns submitted within the quota period
+func (q *CampaignQueue) used(campaigns []*QueuedCampaign) int {
+	now := q.now()
+	used := 0
+	for _, campaign := range campaigns {
+		if !campaign.SubmittedAt.IsZero() && now.Sub(campaign.SubmittedAt) < q.period() {
+			used++
+		}
+	}
+	return used
+}
+
+// isRejectedCampaign reports whether the API rejected campaign params, so submitting them again won't help
+func isRejectedCampaign(apiErr *SendpulseError) bool {
+	switch apiErr.HttpCode {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	}
+	return apiErr.HttpCode >= 400 && apiErr.HttpCode < 500
+}
+
+func (q *CampaignQueue) limit() int {
+	if q.Limit <= 0 {
+		return CampaignsPerHour
+	}
+	return q.Limit
+}
+
+func (q *CampaignQueue) period() time.Duration {
+	if q.Period <= 0 {
+		return time.Hour
+	}
+	return q.Period
+}
+
+func sortQueuedCampaigns(campaigns []*QueuedCampaign) {
+	sort.SliceStable(campaigns, func(i, j int) bool {
+		if campaigns[i].EnqueuedAt.Equal(campaigns[j].EnqueuedAt) {
+			return campaigns[i].ID < campaigns[j].ID
+		}
+		return campaigns[i].EnqueuedAt.Before(campaigns[j].EnqueuedAt)
+	})
+}
+
+func newQueueID() (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,181 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestCampaignQueue() {
+	created := 0
+	suite.mux.HandleFunc("/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		created++
+		fmt.Fprintf(w, `{"id": %d, "status": 13}`, created)
+	})
+	suite.mux.HandleFunc("/campaigns/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "status": 3}`)
+	})
+	for _, id := range []int{3, 4} {
+		id := id
+		suite.mux.HandleFunc(fmt.Sprintf("/campaigns/%d", id), func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"id": %d, "status": 1}`, id)
+		})
+	}
+	canceled := false
+	suite.mux.HandleFunc("/campaigns/2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			canceled = true
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id": 2, "status": 13}`)
+	})
+
+	now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	queue := NewCampaignQueue(suite.client.Emails.Campaigns, nil)
+	queue.now = func() time.Time { return now }
+
+	var queued []*QueuedCampaign
+	for i := 0; i < 6; i++ {
+		campaign, err := queue.Enqueue(context.Background(), CampaignParams{Name: fmt.Sprintf("Campaign %d", i)})
+		suite.NoError(err)
+		queued = append(queued, campaign)
+		now = now.Add(time.Second)
+	}
+
+	submitted, err := queue.Process(context.Background())
+	suite.NoError(err)
+	suite.Len(submitted, CampaignsPerHour)
+	suite.Equal("Campaign 0", submitted[0].Params.Name)
+	suite.Equal(CampaignsPerHour, created)
+
+	submitted, err = queue.Process(context.Background())
+	suite.NoError(err)
+	suite.Len(submitted, 0)
+
+	next, err := queue.NextRelease(context.Background())
+	suite.NoError(err)
+	suite.Equal(now.Add(time.Hour), next)
+
+	suite.NoError(queue.Cancel(context.Background(), queued[5].ID))
+	suite.NoError(queue.Cancel(context.Background(), queued[1].ID))
+	suite.True(canceled)
+	suite.Error(queue.Cancel(context.Background(), queued[5].ID))
+
+	suite.NoError(queue.Refresh(context.Background()))
+	campaign, err := queue.Get(context.Background(), queued[0].ID)
+	suite.NoError(err)
+	suite.Equal(CampaignStatusSent, campaign.Status)
+	suite.True(campaign.IsDone())
+
+	now = now.Add(time.Hour)
+	submitted, err = queue.Process(context.Background())
+	suite.NoError(err)
+	suite.Len(submitted, 1)
+	suite.Equal("Campaign 4", submitted[0].Params.Name)
+}
+
+func (suite *SendpulseTestSuite) TestFileCampaignQueueStore() {
+	dir, err := ioutil.TempDir("", "queue")
+	suite.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "queue.json")
+
+	queue := NewCampaignQueue(suite.client.Emails.Campaigns, NewFileCampaignQueueStore(path))
+	campaign, err := queue.Enqueue(context.Background(), CampaignParams{
+		Name:          "News",
+		MailingListID: 1,
+		SendDate:      DateTimeType(time.Date(2021, 6, 1, 13, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60))),
+	})
+	suite.NoError(err)
+
+	campaigns, err := NewFileCampaignQueueStore(path).List(context.Background())
+	suite.NoError(err)
+	suite.Len(campaigns, 1)
+	stored := campaigns[0]
+	suite.Equal(campaign.ID, stored.ID)
+	suite.Equal("News", stored.Params.Name)
+	suite.Equal(1, stored.Params.MailingListID)
+	suite.Equal(QueuedCampaignPending, stored.State)
+	suite.True(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC).Equal(stored.Params.SendDate.Time()))
+
+	stored, err = queue.Get(context.Background(), campaign.ID)
+	suite.NoError(err)
+	suite.Equal("News", stored.Params.Name)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignQueue_RetryOnServerError() {
+	code := http.StatusServiceUnavailable
+	created := 0
+	suite.mux.HandleFunc("/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		created++
+		if code != http.StatusOK {
+			w.WriteHeader(code)
+			fmt.Fprintf(w, `{"error_code": %d, "message": "error"}`, code)
+			if code == http.StatusBadRequest {
+				code = http.StatusOK
+			}
+			return
+		}
+		fmt.Fprintf(w, `{"id": %d, "status": 13}`, created)
+	})
+
+	queue := NewCampaignQueue(suite.client.Emails.Campaigns, nil)
+	first, err := queue.Enqueue(context.Background(), CampaignParams{Name: "First"})
+	suite.NoError(err)
+	_, err = queue.Enqueue(context.Background(), CampaignParams{Name: "Second"})
+	suite.NoError(err)
+
+	submitted, err := queue.Process(context.Background())
+	suite.NoError(err)
+	suite.Len(submitted, 0)
+	suite.Equal(1, created)
+	campaign, err := queue.Get(context.Background(), first.ID)
+	suite.NoError(err)
+	suite.Equal(QueuedCampaignPending, campaign.State)
+	suite.NotEmpty(campaign.Error)
+
+	code = http.StatusTooManyRequests
+	_, err = queue.Process(context.Background())
+	suite.NoError(err)
+	campaign, err = queue.Get(context.Background(), first.ID)
+	suite.NoError(err)
+	suite.Equal(QueuedCampaignPending, campaign.State)
+
+	code = http.StatusBadRequest
+	submitted, err = queue.Process(context.Background())
+	suite.NoError(err)
+	campaign, err = queue.Get(context.Background(), first.ID)
+	suite.NoError(err)
+	suite.Equal(QueuedCampaignFailed, campaign.State)
+	suite.Len(submitted, 1)
+	suite.Equal("Second", submitted[0].Params.Name)
+	suite.Empty(submitted[0].Error)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignQueue_UnlockedRequests() {
+	var queue *CampaignQueue
+	var queued *QueuedCampaign
+	suite.mux.HandleFunc("/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		suite.NoError(queue.Refresh(context.Background()))
+		suite.Error(queue.Cancel(context.Background(), queued.ID))
+		fmt.Fprintf(w, `{"id": 1, "status": 13}`)
+	})
+
+	queue = NewCampaignQueue(suite.client.Emails.Campaigns, nil)
+	queue.Limit = 1
+	var err error
+	queued, err = queue.Enqueue(context.Background(), CampaignParams{Name: "First"})
+	suite.NoError(err)
+	_, err = queue.Enqueue(context.Background(), CampaignParams{Name: "Second"})
+	suite.NoError(err)
+
+	submitted, err := queue.Process(context.Background())
+	suite.NoError(err)
+	suite.Len(submitted, 1)
+	suite.Equal(QueuedCampaignSubmitted, submitted[0].State)
+}
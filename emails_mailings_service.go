@@ -3,6 +3,7 @@ package sendpulse_sdk_go
 import (
 	"context"
 	b64 "encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -35,6 +36,15 @@ type CampaignParams struct {
 	BodyAMP       string            `json:"body_amp,omitempty"`
 }
 
+// MarshalJSON omits SendDate if it isn't set
+func (p CampaignParams) MarshalJSON() ([]byte, error) {
+	type params CampaignParams
+	return json.Marshal(struct {
+		params
+		SendDate *DateTimeType `json:"send_date,omitempty"`
+	}{params(p), p.SendDate.ptr()})
+}
+
 // Campaign describes a campaign
 type Campaign struct {
 	ID      int    `json:"id"`
@@ -47,13 +57,14 @@ type Campaign struct {
 		Attachments   string `json:"attachments"`
 		MailingListID int    `json:"list_id"`
 	}
-	Status            int          `json:"status"`
-	AllEmailQty       int          `json:"all_email_qty"`
-	TariffEmailQty    int          `json:"tariff_email_qty"`
-	PaidEmailQty      int          `json:"paid_email_qty"`
-	OverdraftPrice    float32      `json:"overdraft_price"`
-	OverdraftCurrency string       `json:"overdraft_currency"`
-	SendDate          DateTimeType `json:"send_date"`
+	Status            CampaignStatus     `json:"status"`
+	AllEmailQty       int                `json:"all_email_qty"`
+	TariffEmailQty    int                `json:"tariff_email_qty"`
+	PaidEmailQty      int                `json:"paid_email_qty"`
+	OverdraftPrice    float32            `json:"overdraft_price"`
+	OverdraftCurrency string             `json:"overdraft_currency"`
+	SendDate          DateTimeType       `json:"send_date"`
+	Statistics        CampaignStatistics `json:"statistics"`
 }
 
 // CreateCampaign creates a campaign. Please note that you can send a maximum of 4 campaigns per hour
@@ -71,6 +82,7 @@ func (service *CampaignsService) CreateCampaign(ctx context.Context, data Campai
 	if data.BodyAMP != "" {
 		data.BodyAMP = b64.StdEncoding.EncodeToString([]byte(data.BodyAMP))
 	}
+	data.SendDate = service.client.accountDate(data.SendDate)
 
 	_, err := service.client.newRequest(ctx, http.MethodPost, path, data, &innerMailing, true)
 	if err != nil {
@@ -99,6 +111,7 @@ func (service *CampaignsService) UpdateCampaign(ctx context.Context, id int, dat
 	if data.BodyAMP != "" {
 		data.BodyAMP = b64.StdEncoding.EncodeToString([]byte(data.BodyAMP))
 	}
+	data.SendDate = service.client.accountDate(data.SendDate)
 
 	_, err := service.client.newRequest(ctx, http.MethodPatch, path, data, &respData, true)
 	return err
@@ -122,9 +135,9 @@ func (service *CampaignsService) GetCampaigns(ctx context.Context, limit int, of
 
 // Task represents a campaign
 type Task struct {
-	ID     int    `json:"task_id"`
-	Name   string `json:"task_name"`
-	Status int    `json:"task_status"`
+	ID     int            `json:"task_id"`
+	Name   string         `json:"task_name"`
+	Status CampaignStatus `json:"task_status"`
 }
 
 // GetCampaignsByMailingList returns a list of campaigns by specific mailing list
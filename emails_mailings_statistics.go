@@ -0,0 +1,85 @@
+package sendpulse_sdk_go
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// CampaignStatistics represents delivery and engagement statistics of a campaign
+type CampaignStatistics struct {
+	Sent         int `json:"sent"`
+	Delivered    int `json:"delivered"`
+	Opened       int `json:"opening"`
+	Clicked      int `json:"link_redirected"`
+	Unsubscribed int `json:"unsubscribe"`
+	Errors       int `json:"error"`
+}
+
+// UnmarshalJSON decodes statistics. The API returns an array instead of an object for campaigns without statistics
+func (s *CampaignStatistics) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) || bytes.HasPrefix(trimmed, []byte("[")) {
+		*s = CampaignStatistics{}
+		return nil
+	}
+	type statistics CampaignStatistics
+	return json.Unmarshal(data, (*statistics)(s))
+}
+
+// Add returns the sum of the statistics
+func (s CampaignStatistics) Add(other CampaignStatistics) CampaignStatistics {
+	return CampaignStatistics{
+		Sent:         s.Sent + other.Sent,
+		Delivered:    s.Delivered + other.Delivered,
+		Opened:       s.Opened + other.Opened,
+		Clicked:      s.Clicked + other.Clicked,
+		Unsubscribed: s.Unsubscribed + other.Unsubscribed,
+		Errors:       s.Errors + other.Errors,
+	}
+}
+
+// DeliveryRate returns the share of sent emails which were delivered
+func (s CampaignStatistics) DeliveryRate() float64 {
+	return ratio(s.Delivered, s.Sent)
+}
+
+// OpenRate returns the share of delivered emails which were opened
+func (s CampaignStatistics) OpenRate() float64 {
+	return ratio(s.Opened, s.Delivered)
+}
+
+// ClickRate returns the share of delivered emails with clicked links (CTR)
+func (s CampaignStatistics) ClickRate() float64 {
+	return ratio(s.Clicked, s.Delivered)
+}
+
+// ClickToOpenRate returns the share of opened emails with clicked links
+func (s CampaignStatistics) ClickToOpenRate() float64 {
+	return ratio(s.Clicked, s.Opened)
+}
+
+// BounceRate returns the share of sent emails which weren't delivered
+func (s CampaignStatistics) BounceRate() float64 {
+	return ratio(s.Errors, s.Sent)
+}
+
+// UnsubscribeRate returns the share of delivered emails which led to unsubscription
+func (s CampaignStatistics) UnsubscribeRate() float64 {
+	return ratio(s.Unsubscribed, s.Delivered)
+}
+
+// SummarizeCampaignStatistics returns total statistics of campaigns
+func SummarizeCampaignStatistics(campaigns []*Campaign) CampaignStatistics {
+	var total CampaignStatistics
+	for _, campaign := range campaigns {
+		total = total.Add(campaign.Statistics)
+	}
+	return total
+}
+
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
@@ -0,0 +1,51 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (suite *SendpulseTestSuite) TestCampaignStatistics() {
+	suite.mux.HandleFunc("/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[
+			{
+				"id": 1,
+				"status": 3,
+				"statistics": {"sent": 100, "delivered": 90, "opening": 45, "link_redirected": 9, "unsubscribe": 1, "error": 10}
+			},
+			{
+				"id": 2,
+				"status": 3,
+				"statistics": {"sent": 100, "delivered": 100, "opening": 15, "link_redirected": 3, "unsubscribe": 0, "error": 0}
+			},
+			{
+				"id": 3,
+				"status": 13,
+				"statistics": []
+			},
+			{
+				"id": 4,
+				"status": 13,
+				"statistics": [{"code": 1, "count": 0, "explain": "Sent"}]
+			}
+		]`)
+	})
+
+	campaigns, err := suite.client.Emails.Campaigns.GetCampaigns(context.Background(), 10, 0)
+	suite.NoError(err)
+	suite.Len(campaigns, 4)
+	suite.Equal(CampaignStatistics{}, campaigns[3].Statistics)
+	suite.Equal(0.5, campaigns[0].Statistics.OpenRate())
+	suite.Equal(0.1, campaigns[0].Statistics.BounceRate())
+	suite.Equal(CampaignStatistics{}, campaigns[2].Statistics)
+	suite.Equal(0.0, campaigns[2].Statistics.OpenRate())
+
+	total := SummarizeCampaignStatistics(campaigns)
+	suite.Equal(200, total.Sent)
+	suite.Equal(0.95, total.DeliveryRate())
+	suite.InDelta(60.0/190, total.OpenRate(), 1e-9)
+	suite.InDelta(12.0/190, total.ClickRate(), 1e-9)
+	suite.Equal(0.2, total.ClickToOpenRate())
+	suite.Equal(0.05, total.BounceRate())
+}
@@ -0,0 +1,135 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultCampaignPollInterval = 30 * time.Second
+	defaultCampaignWaitTimeout  = 24 * time.Hour
+)
+
+// CampaignStatusEvent describes a change of a campaign status
+type CampaignStatusEvent struct {
+	Campaign       *Campaign
+	PreviousStatus CampaignStatus
+	// First is true for the first received status of the campaign
+	First bool
+	Time  time.Time
+}
+
+// CampaignWaitOptions describes options of waiting for a campaign
+type CampaignWaitOptions struct {
+	PollInterval   time.Duration // Interval between campaign requests (default: 30 seconds)
+	MaxPolls       int           // Max number of campaign requests, zero means no limit other than Timeout
+	Timeout        time.Duration // Max waiting time (default: 24 hours)
+	OnStatusChange func(event CampaignStatusEvent)
+}
+
+// CampaignWaitError is returned when a campaign isn't finished within MaxPolls or Timeout
+type CampaignWaitError struct {
+	CampaignID int
+	Status     CampaignStatus
+	Polls      int
+	Elapsed    time.Duration
+}
+
+// Error returns string representation of the CampaignWaitError
+func (e *CampaignWaitError) Error() string {
+	return fmt.Sprintf("campaign %d is still %s after %d polls in %s", e.CampaignID, e.Status, e.Polls, e.Elapsed.Round(time.Second))
+}
+
+// CampaignReport represents final information about a campaign
+type CampaignReport struct {
+	Campaign  *Campaign
+	Countries map[string]int
+	Referrals []*MailingRefStat
+}
+
+// Clicks returns the total number of clicks of all referrals
+func (r *CampaignReport) Clicks() int {
+	clicks := 0
+	for _, referral := range r.Referrals {
+		clicks += referral.Count
+	}
+	return clicks
+}
+
+// GetCampaignReport returns the campaign info with its country and referral statistics
+func (service *CampaignsService) GetCampaignReport(ctx context.Context, id int) (*CampaignReport, error) {
+	campaign, err := service.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return service.buildCampaignReport(ctx, campaign)
+}
+
+// WaitForCampaign polls a campaign until its status is final and returns the campaign report.
+// Statistics are requested only for sent campaigns. CampaignWaitError is returned
+// if the campaign isn't finished within options.MaxPolls or options.Timeout
+func (service *CampaignsService) WaitForCampaign(ctx context.Context, id int, options CampaignWaitOptions) (*CampaignReport, error) {
+	interval := options.PollInterval
+	if interval <= 0 {
+		interval = defaultCampaignPollInterval
+	}
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = defaultCampaignWaitTimeout
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	started := time.Now()
+	var previous *Campaign
+	for polls := 1; ; polls++ {
+		campaign, err := service.GetCampaign(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if options.OnStatusChange != nil && (previous == nil || previous.Status != campaign.Status) {
+			event := CampaignStatusEvent{Campaign: campaign, First: previous == nil, Time: time.Now()}
+			if previous != nil {
+				event.PreviousStatus = previous.Status
+			}
+			options.OnStatusChange(event)
+		}
+		if campaign.Status.IsFinal() {
+			return service.buildCampaignReport(ctx, campaign)
+		}
+		previous = campaign
+
+		waitErr := &CampaignWaitError{CampaignID: id, Status: campaign.Status, Polls: polls}
+		if options.MaxPolls > 0 && polls >= options.MaxPolls {
+			waitErr.Elapsed = time.Since(started)
+			return nil, waitErr
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			waitErr.Elapsed = time.Since(started)
+			return nil, waitErr
+		case <-ticker.C:
+		}
+	}
+}
+
+func (service *CampaignsService) buildCampaignReport(ctx context.Context, campaign *Campaign) (*CampaignReport, error) {
+	report := &CampaignReport{Campaign: campaign}
+	if campaign.Status != CampaignStatusSent {
+		return report, nil
+	}
+
+	var err error
+	if report.Countries, err = service.GetCampaignCountriesStatistics(ctx, campaign.ID); err != nil {
+		return report, err
+	}
+	if report.Referrals, err = service.GetCampaignReferralsStatistics(ctx, campaign.ID); err != nil {
+		return report, err
+	}
+	return report, nil
+}
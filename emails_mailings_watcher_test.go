@@ -0,0 +1,76 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestCampaignsService_WaitForCampaign() {
+	statuses := []int{13, 13, 1, 3}
+	requests := 0
+	suite.mux.HandleFunc("/campaigns/1", func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[requests]
+		if requests < len(statuses)-1 {
+			requests++
+		}
+		fmt.Fprintf(w, `{"id": 1, "name": "News", "status": %d}`, status)
+	})
+	suite.mux.HandleFunc("/campaigns/1/countries", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"UA": 10, "US": 5}`)
+	})
+	suite.mux.HandleFunc("/campaigns/1/referrals", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"link": "https://sendpulse.com", "count": 3}, {"link": "https://google.com", "count": 2}]`)
+	})
+
+	var events []CampaignStatusEvent
+	report, err := suite.client.Emails.Campaigns.WaitForCampaign(context.Background(), 1, CampaignWaitOptions{
+		PollInterval: time.Millisecond,
+		OnStatusChange: func(event CampaignStatusEvent) {
+			events = append(events, event)
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(CampaignStatusSent, report.Campaign.Status)
+	suite.Equal(10, report.Countries["UA"])
+	suite.Equal(5, report.Clicks())
+
+	suite.Len(events, 3)
+	suite.True(events[0].First)
+	suite.Equal(CampaignStatusModeration, events[1].PreviousStatus)
+	suite.Equal(CampaignStatusSending, events[2].PreviousStatus)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignsService_WaitForCampaign_Timeout() {
+	suite.mux.HandleFunc("/campaigns/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "status": 1}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := suite.client.Emails.Campaigns.WaitForCampaign(ctx, 1, CampaignWaitOptions{PollInterval: time.Millisecond})
+	suite.Error(err)
+}
+
+func (suite *SendpulseTestSuite) TestCampaignsService_WaitForCampaign_MaxPolls() {
+	requests := 0
+	suite.mux.HandleFunc("/campaigns/1", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"id": 1, "status": 13}`)
+	})
+
+	_, err := suite.client.Emails.Campaigns.WaitForCampaign(context.Background(), 1, CampaignWaitOptions{
+		PollInterval: time.Millisecond,
+		MaxPolls:     3,
+	})
+	suite.IsType(&CampaignWaitError{}, err)
+	suite.Equal(CampaignStatusModeration, err.(*CampaignWaitError).Status)
+	suite.Equal(3, requests)
+
+	_, err = suite.client.Emails.Campaigns.WaitForCampaign(context.Background(), 1, CampaignWaitOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	})
+	suite.IsType(&CampaignWaitError{}, err)
+}
@@ -0,0 +1,244 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SegmentsService is a service to interact with segments of mailing lists
+type SegmentsService struct {
+	client *Client
+}
+
+// newSegmentsService creates SegmentsService
+func newSegmentsService(cl *Client) *SegmentsService {
+	return &SegmentsService{client: cl}
+}
+
+// SegmentOperator is an operator of a segment condition
+type SegmentOperator string
+
+const (
+	SegmentOperatorEqual       SegmentOperator = "eq"
+	SegmentOperatorNotEqual    SegmentOperator = "neq"
+	SegmentOperatorContains    SegmentOperator = "contains"
+	SegmentOperatorNotContains SegmentOperator = "not_contains"
+	SegmentOperatorGreater     SegmentOperator = "gt"
+	SegmentOperatorLess        SegmentOperator = "lt"
+	SegmentOperatorEmpty       SegmentOperator = "empty"
+	SegmentOperatorNotEmpty    SegmentOperator = "not_empty"
+)
+
+// segmentOperatorTypes lists variable types supported by operators
+var segmentOperatorTypes = map[SegmentOperator][]VariableType{
+	SegmentOperatorEqual:       {VariableTypeString, VariableTypeNumber, VariableTypeDate},
+	SegmentOperatorNotEqual:    {VariableTypeString, VariableTypeNumber, VariableTypeDate},
+	SegmentOperatorContains:    {VariableTypeString},
+	SegmentOperatorNotContains: {VariableTypeString},
+	SegmentOperatorGreater:     {VariableTypeNumber, VariableTypeDate},
+	SegmentOperatorLess:        {VariableTypeNumber, VariableTypeDate},
+	SegmentOperatorEmpty:       {VariableTypeString, VariableTypeNumber, VariableTypeDate},
+	SegmentOperatorNotEmpty:    {VariableTypeString, VariableTypeNumber, VariableTypeDate},
+}
+
+// SegmentMatch defines how segment conditions are combined
+type SegmentMatch string
+
+const (
+	SegmentMatchAll SegmentMatch = "all"
+	SegmentMatchAny SegmentMatch = "any"
+)
+
+// SegmentCondition is a filter condition on a mailing list variable.
+// Values of date variables can be passed as time.Time or DateTimeType
+type SegmentCondition struct {
+	Variable string          `json:"variable"`
+	Operator SegmentOperator `json:"operator"`
+	Value    interface{}     `json:"value,omitempty"`
+}
+
+// MarshalJSON formats date values in their own location. Validate converts them to the account location
+func (c SegmentCondition) MarshalJSON() ([]byte, error) {
+	type condition SegmentCondition
+	switch c.Value.(type) {
+	case time.Time, DateTimeType, *DateTimeType:
+		date, err := encodeDateValue(c.Value, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.Value = date
+	}
+	return json.Marshal(condition(c))
+}
+
+// SegmentParams describes params of a segment
+type SegmentParams struct {
+	Name       string              `json:"name"`
+	Match      SegmentMatch        `json:"match"`
+	Conditions []*SegmentCondition `json:"conditions"`
+}
+
+// Validate checks the segment params against variables of the mailing list.
+// Conditions are replaced with copies whose number and date values are converted to the format expected by SendPulse,
+// dates in the account location (default: UTC). The conditions passed by the caller aren't changed
+func (p *SegmentParams) Validate(variables []*VariableMeta, loc *time.Location) error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("segment name is empty")
+	}
+	if p.Match != "" && p.Match != SegmentMatchAll && p.Match != SegmentMatchAny {
+		return fmt.Errorf("unsupported segment match %q", p.Match)
+	}
+	if len(p.Conditions) == 0 {
+		return fmt.Errorf("segment has no conditions")
+	}
+
+	types := make(map[string]VariableType, len(variables))
+	for _, variable := range variables {
+		types[strings.ToLower(variable.Name)] = variable.Type
+	}
+	conditions := make([]*SegmentCondition, len(p.Conditions))
+	for i, condition := range p.Conditions {
+		variableType, ok := types[strings.ToLower(condition.Variable)]
+		if !ok {
+			return fmt.Errorf("variable %q is not found in the mailing list", condition.Variable)
+		}
+		value, err := condition.validate(variableType, loc)
+		if err != nil {
+			return err
+		}
+		normalized := *condition
+		normalized.Value = value
+		conditions[i] = &normalized
+	}
+	p.Conditions = conditions
+	return nil
+}
+
+// validate checks the condition against the variable type and returns its value in the format expected by SendPulse
+func (c *SegmentCondition) validate(variableType VariableType, loc *time.Location) (interface{}, error) {
+	supported, ok := segmentOperatorTypes[c.Operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operator %q", c.Operator)
+	}
+	if !containsVariableType(supported, variableType) {
+		return nil, fmt.Errorf("operator %q can't be applied to %s variable %q", c.Operator, variableType, c.Variable)
+	}
+
+	if c.Operator == SegmentOperatorEmpty || c.Operator == SegmentOperatorNotEmpty {
+		if c.Value != nil {
+			return nil, fmt.Errorf("operator %q of variable %q doesn't accept a value", c.Operator, c.Variable)
+		}
+		return nil, nil
+	}
+	if c.Value == nil {
+		return nil, fmt.Errorf("condition of variable %q has no value", c.Variable)
+	}
+
+	var value interface{}
+	var err error
+	switch variableType {
+	case VariableTypeString:
+		value = c.Value
+		if _, ok := c.Value.(string); !ok {
+			err = fmt.Errorf("%v is not a string", c.Value)
+		}
+	case VariableTypeNumber:
+		value, err = numberValue(c.Value)
+	case VariableTypeDate:
+		value, err = encodeDateValue(c.Value, loc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("value %v doesn't match %s variable %q", c.Value, variableType, c.Variable)
+	}
+	return value, nil
+}
+
+// Segment describes a segment of a mailing list
+type Segment struct {
+	ID            int                 `json:"id"`
+	MailingListID int                 `json:"book_id"`
+	Name          string              `json:"name"`
+	Match         SegmentMatch        `json:"match"`
+	Conditions    []*SegmentCondition `json:"conditions"`
+	EmailQty      int                 `json:"email_qty"`
+	Created       DateTimeType        `json:"created"`
+}
+
+// CreateSegment creates a segment of a mailing list. Conditions are validated against the mailing list variables
+func (service *SegmentsService) CreateSegment(ctx context.Context, mailingListID int, params SegmentParams) (int, error) {
+	if err := service.validate(ctx, mailingListID, &params); err != nil {
+		return 0, err
+	}
+
+	path := fmt.Sprintf("/addressbooks/%d/segments", mailingListID)
+	var response struct {
+		Result bool `json:"result"`
+		ID     int  `json:"id"`
+	}
+	_, err := service.client.newRequest(ctx, http.MethodPost, path, params, &response, true)
+	return response.ID, err
+}
+
+// UpdateSegment changes a segment of a mailing list
+func (service *SegmentsService) UpdateSegment(ctx context.Context, mailingListID int, segmentID int, params SegmentParams) error {
+	if err := service.validate(ctx, mailingListID, &params); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/addressbooks/%d/segments/%d", mailingListID, segmentID)
+	var response struct {
+		Result bool `json:"result"`
+	}
+	_, err := service.client.newRequest(ctx, http.MethodPatch, path, params, &response, true)
+	return err
+}
+
+// GetSegment returns a segment of a mailing list
+func (service *SegmentsService) GetSegment(ctx context.Context, mailingListID int, segmentID int) (*Segment, error) {
+	path := fmt.Sprintf("/addressbooks/%d/segments/%d", mailingListID, segmentID)
+	var segment Segment
+	_, err := service.client.newRequest(ctx, http.MethodGet, path, nil, &segment, true)
+	return &segment, err
+}
+
+// GetSegments returns segments of a mailing list
+func (service *SegmentsService) GetSegments(ctx context.Context, mailingListID int) ([]*Segment, error) {
+	path := fmt.Sprintf("/addressbooks/%d/segments", mailingListID)
+	var segments []*Segment
+	_, err := service.client.newRequest(ctx, http.MethodGet, path, nil, &segments, true)
+	return segments, err
+}
+
+// DeleteSegment removes a segment of a mailing list
+func (service *SegmentsService) DeleteSegment(ctx context.Context, mailingListID int, segmentID int) error {
+	path := fmt.Sprintf("/addressbooks/%d/segments/%d", mailingListID, segmentID)
+	var response struct {
+		Result bool `json:"result"`
+	}
+	_, err := service.client.newRequest(ctx, http.MethodDelete, path, nil, &response, true)
+	return err
+}
+
+func (service *SegmentsService) validate(ctx context.Context, mailingListID int, params *SegmentParams) error {
+	if params.Match == "" {
+		params.Match = SegmentMatchAll
+	}
+	variables, err := service.client.Emails.MailingLists.GetMailingListVariables(ctx, mailingListID)
+	if err != nil {
+		return err
+	}
+	return params.Validate(variables, service.client.Location())
+}
+
+func containsVariableType(types []VariableType, variableType VariableType) bool {
+	for _, t := range types {
+		if t == variableType {
+			return true
+		}
+	}
+	return false
+}
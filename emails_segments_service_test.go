@@ -0,0 +1,108 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) handleMailingListVariables() {
+	suite.mux.HandleFunc("/addressbooks/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[
+			{"name": "Name", "type": "string"},
+			{"name": "age", "type": "number"},
+			{"name": "birthday", "type": "date"}
+		]`)
+	})
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_SegmentsService_Create() {
+	suite.handleMailingListVariables()
+	suite.mux.HandleFunc("/addressbooks/1/segments", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		var body struct {
+			Match      string `json:"match"`
+			Conditions []struct {
+				Variable string      `json:"variable"`
+				Operator string      `json:"operator"`
+				Value    interface{} `json:"value"`
+			} `json:"conditions"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		suite.Equal("all", body.Match)
+		suite.Equal(17.0, body.Conditions[0].Value)
+		suite.Equal("2000-01-02", body.Conditions[1].Value)
+		suite.Equal("1990-05-06", body.Conditions[2].Value)
+		fmt.Fprintf(w, `{"result": true, "id": 7}`)
+	})
+
+	id, err := suite.client.Emails.Segments.CreateSegment(context.Background(), 1, SegmentParams{
+		Name: "Adults",
+		Conditions: []*SegmentCondition{
+			{Variable: "age", Operator: SegmentOperatorGreater, Value: 17},
+			{Variable: "birthday", Operator: SegmentOperatorLess, Value: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)},
+			{Variable: "birthday", Operator: SegmentOperatorGreater, Value: NewDateTime(time.Date(1990, 5, 6, 12, 0, 0, 0, time.UTC))},
+			{Variable: "name", Operator: SegmentOperatorNotEmpty},
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(7, id)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_SegmentsService_Validate() {
+	variables := []*VariableMeta{
+		{Name: "name", Type: VariableTypeString},
+		{Name: "age", Type: VariableTypeNumber},
+	}
+	invalid := []*SegmentCondition{
+		{Variable: "city", Operator: SegmentOperatorEqual, Value: "Kyiv"},
+		{Variable: "age", Operator: SegmentOperatorContains, Value: "1"},
+		{Variable: "age", Operator: SegmentOperatorGreater, Value: "ten"},
+		{Variable: "name", Operator: SegmentOperatorEqual},
+		{Variable: "name", Operator: SegmentOperatorEmpty, Value: "x"},
+		{Variable: "name", Operator: "like", Value: "x"},
+	}
+	for _, condition := range invalid {
+		params := SegmentParams{Name: "Segment", Conditions: []*SegmentCondition{condition}}
+		suite.Error(params.Validate(variables, nil), condition.Variable)
+	}
+
+	condition := &SegmentCondition{Variable: "age", Operator: SegmentOperatorLess, Value: "18"}
+	params := SegmentParams{Name: "Segment", Conditions: []*SegmentCondition{condition}}
+	suite.NoError(params.Validate(variables, nil))
+	suite.Equal(int64(18), params.Conditions[0].Value)
+	suite.Equal("18", condition.Value)
+
+	b, err := json.Marshal(SegmentCondition{Variable: "birthday", Operator: SegmentOperatorLess, Value: NewDateTime(time.Date(2000, 1, 2, 10, 0, 0, 0, time.UTC))})
+	suite.NoError(err)
+	suite.Contains(string(b), `"value":"2000-01-02"`)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_SegmentsService_Get() {
+	suite.mux.HandleFunc("/addressbooks/1/segments", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodGet, r.Method)
+		fmt.Fprintf(w, `[{"id": 7, "book_id": 1, "name": "Adults", "match": "all", "email_qty": 10,
+			"conditions": [{"variable": "age", "operator": "gt", "value": 17}], "created": "2021-06-01 10:00:00"}]`)
+	})
+	suite.mux.HandleFunc("/addressbooks/1/segments/7", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprintf(w, `{"id": 7, "book_id": 1, "name": "Adults", "match": "all", "email_qty": 10}`)
+		case http.MethodDelete:
+			fmt.Fprintf(w, `{"result": true}`)
+		}
+	})
+
+	segments, err := suite.client.Emails.Segments.GetSegments(context.Background(), 1)
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	suite.Equal(SegmentOperatorGreater, segments[0].Conditions[0].Operator)
+
+	segment, err := suite.client.Emails.Segments.GetSegment(context.Background(), 1, 7)
+	suite.NoError(err)
+	suite.Equal(10, segment.EmailQty)
+
+	suite.NoError(suite.client.Emails.Segments.DeleteSegment(context.Background(), 1, 7))
+}
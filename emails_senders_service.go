@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 type SendersService struct {
@@ -94,3 +95,18 @@ func (service *SendersService) DeleteSender(ctx context.Context, email string) e
 	_, err := service.client.newRequest(ctx, http.MethodDelete, path, params, &response, true)
 	return err
 }
+
+// findSender returns a sender by its email or nil
+func findSender(senders []*Sender, email string) *Sender {
+	for _, sender := range senders {
+		if strings.EqualFold(sender.Email, email) {
+			return sender
+		}
+	}
+	return nil
+}
+
+// isActiveSender reports whether a sender can be used in campaigns
+func isActiveSender(sender *Sender) bool {
+	return strings.EqualFold(sender.Status, "active")
+}
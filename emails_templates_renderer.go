@@ -0,0 +1,156 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	templateVariableRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z_][\w.\-]*)\s*(?:\|\s*default\s*(?:\(\s*(?:'([^']*)'|"([^"]*)")\s*\)|:\s*"([^"]*)"))?\s*\}\}`)
+	htmlHiddenRegexp       = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>`)
+	htmlLinkRegexp         = regexp.MustCompile(`(?is)<a\s[^>]*href\s*=\s*["']([^"']+)["'][^>]*>(.*?)</a>`)
+	htmlBlockRegexp        = regexp.MustCompile(`(?i)</(p|div|h[1-6]|table)>`)
+	htmlLineBreakRegexp    = regexp.MustCompile(`(?i)<br\s*/?>|</(li|tr)>`)
+	htmlTagRegexp          = regexp.MustCompile(`(?s)<[^>]*>`)
+	spacesRegexp           = regexp.MustCompile(`[ \t]+`)
+	emptyLinesRegexp       = regexp.MustCompile(`\n{3,}`)
+)
+
+// TemplateVariable describes a variable placeholder of a template
+type TemplateVariable struct {
+	Name       string
+	Default    string
+	HasDefault bool
+}
+
+// TemplateRenderResult represents a rendered template
+type TemplateRenderResult struct {
+	Html string
+	Text string
+	// Missing lists variables without a value and without a default
+	Missing []string
+	// Defaulted lists variables rendered with their default values
+	Defaulted []string
+}
+
+// ParseTemplateVariables returns all variables a template expects in order of their first appearance.
+// Both {{name}} and {{name|default('value')}} placeholders are supported
+func ParseTemplateVariables(body string) []*TemplateVariable {
+	var variables []*TemplateVariable
+	seen := make(map[string]*TemplateVariable)
+	for _, match := range templateVariableRegexp.FindAllStringSubmatch(body, -1) {
+		defaultValue, hasDefault := placeholderDefault(match)
+		if variable, ok := seen[match[1]]; ok {
+			if hasDefault && !variable.HasDefault {
+				variable.Default, variable.HasDefault = defaultValue, true
+			}
+			continue
+		}
+		variable := &TemplateVariable{Name: match[1], Default: defaultValue, HasDefault: hasDefault}
+		seen[match[1]] = variable
+		variables = append(variables, variable)
+	}
+	return variables
+}
+
+// TemplateVariableNames returns sorted names of all variables a template expects
+func TemplateVariableNames(body string) []string {
+	variables := ParseTemplateVariables(body)
+	names := make([]string, len(variables))
+	for i, variable := range variables {
+		names[i] = variable.Name
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RenderTemplate substitutes variable placeholders of a template body and builds its plain text version.
+// Values are HTML-escaped. Placeholders of missing variables without defaults are left as is and reported in Missing
+func RenderTemplate(body string, variables map[string]interface{}) *TemplateRenderResult {
+	result := &TemplateRenderResult{}
+	missing := make(map[string]bool)
+	defaulted := make(map[string]bool)
+
+	result.Html = templateVariableRegexp.ReplaceAllStringFunc(body, func(placeholder string) string {
+		match := templateVariableRegexp.FindStringSubmatch(placeholder)
+		name := match[1]
+		if value, ok := variables[name]; ok && value != nil {
+			return html.EscapeString(fmt.Sprint(value))
+		}
+		if defaultValue, ok := placeholderDefault(match); ok {
+			if !defaulted[name] {
+				defaulted[name] = true
+				result.Defaulted = append(result.Defaulted, name)
+			}
+			return html.EscapeString(defaultValue)
+		}
+		if !missing[name] {
+			missing[name] = true
+			result.Missing = append(result.Missing, name)
+		}
+		return placeholder
+	})
+
+	result.Text = HtmlToText(result.Html)
+	return result
+}
+
+// HtmlToText converts an HTML email body to plain text. Links are kept as "text (url)"
+func HtmlToText(body string) string {
+	text := htmlHiddenRegexp.ReplaceAllString(body, "")
+	text = htmlLinkRegexp.ReplaceAllStringFunc(text, func(link string) string {
+		match := htmlLinkRegexp.FindStringSubmatch(link)
+		label := strings.TrimSpace(htmlTagRegexp.ReplaceAllString(match[2], ""))
+		if label == "" || label == match[1] {
+			return match[1]
+		}
+		return fmt.Sprintf("%s (%s)", label, match[1])
+	})
+	text = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text)
+	text = htmlBlockRegexp.ReplaceAllString(text, "\n\n")
+	text = htmlLineBreakRegexp.ReplaceAllString(text, "\n")
+	text = htmlTagRegexp.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	text = spacesRegexp.ReplaceAllString(text, " ")
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	text = emptyLinesRegexp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(text)
+}
+
+// DecodedBody returns the template body decoding it from base64 if needed
+func (t *Template) DecodedBody() string {
+	decoded, err := b64.StdEncoding.DecodeString(t.Body)
+	if err != nil || !utf8.Valid(decoded) {
+		return t.Body
+	}
+	return string(decoded)
+}
+
+// RenderTemplate loads a template and renders it with the variables
+func (service *TemplatesService) RenderTemplate(ctx context.Context, templateID int, variables map[string]interface{}) (*TemplateRenderResult, error) {
+	template, err := service.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return RenderTemplate(template.DecodedBody(), variables), nil
+}
+
+// placeholderDefault returns a default value of a placeholder match
+func placeholderDefault(match []string) (string, bool) {
+	for _, value := range match[2:] {
+		if value != "" {
+			return value, true
+		}
+	}
+	return "", strings.Contains(match[0], "|")
+}
@@ -0,0 +1,55 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"fmt"
+	"net/http"
+)
+
+const testTemplateBody = `<html><head><style>h1 {color: red;}</style></head><body>
+<h1>Hello, {{ name|default('friend') }}!</h1>
+<p>Your order {{order_id}} is &quot;ready&quot;.<br>Total: {{ total }}</p>
+<p><a href="https://example.com/orders/{{order_id}}">Open order</a></p>
+<p>{{ default_name }}</p>
+</body></html>`
+
+func (suite *SendpulseTestSuite) TestTemplateRenderer_Variables() {
+	variables := ParseTemplateVariables(testTemplateBody)
+	suite.Len(variables, 4)
+	suite.Equal("name", variables[0].Name)
+	suite.True(variables[0].HasDefault)
+	suite.Equal("friend", variables[0].Default)
+	suite.False(variables[3].HasDefault)
+
+	suite.Equal([]string{"default_name", "name", "order_id", "total"}, TemplateVariableNames(testTemplateBody))
+}
+
+func (suite *SendpulseTestSuite) TestTemplateRenderer_Render() {
+	result := RenderTemplate(testTemplateBody, map[string]interface{}{
+		"order_id":     42,
+		"total":        "<b>10$</b>",
+		"default_name": "x",
+	})
+	suite.Contains(result.Html, "Hello, friend!")
+	suite.Contains(result.Html, "&lt;b&gt;10$&lt;/b&gt;")
+	suite.Equal([]string{"name"}, result.Defaulted)
+	suite.Empty(result.Missing)
+	suite.Equal("Hello, friend!\n\nYour order 42 is \"ready\".\nTotal: <b>10$</b>\n\nOpen order (https://example.com/orders/42)\n\nx", result.Text)
+
+	result = RenderTemplate(testTemplateBody, nil)
+	suite.Equal([]string{"order_id", "total", "default_name"}, result.Missing)
+	suite.Contains(result.Html, "{{order_id}}")
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_Render() {
+	suite.mux.HandleFunc("/template/1", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodGet, r.Method)
+		fmt.Fprintf(w, `{"id": "1", "real_id": 1, "body": "%s"}`, b64.StdEncoding.EncodeToString([]byte("<h1>Hi {{name}}</h1>")))
+	})
+
+	result, err := suite.client.Emails.Templates.RenderTemplate(context.Background(), 1, map[string]interface{}{"name": "Bob"})
+	suite.NoError(err)
+	suite.Equal("<h1>Hi Bob</h1>", result.Html)
+	suite.Equal("Hi Bob", result.Text)
+}
@@ -0,0 +1,155 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const templatesPageSize = 100
+
+// TemplateFilter describes conditions to search templates. Empty fields are ignored
+type TemplateFilter struct {
+	Owner       string    // Owner of templates: "me" or "sendpulse" (passed to the API)
+	Name        string    // Case-insensitive substring of the template name
+	Lang        string    // Language of the template
+	Tags        []string  // Tags which must all be present in the template
+	Category    string    // Category code or name
+	CreatedFrom time.Time // Templates created at or after the time
+	CreatedTo   time.Time // Templates created before the time
+}
+
+// IsEmpty reports whether the filter has no conditions besides the owner and matches every template
+func (f *TemplateFilter) IsEmpty() bool {
+	return f.Name == "" && f.Lang == "" && len(f.Tags) == 0 && f.Category == "" && f.CreatedFrom.IsZero() && f.CreatedTo.IsZero()
+}
+
+// DeleteTemplatesOptions controls removal of templates by a filter
+type DeleteTemplatesOptions struct {
+	All    bool // Allow an empty filter removing all the user's templates
+	DryRun bool // Only return templates which would be removed
+}
+
+// Match reports whether the template satisfies the filter
+func (f *TemplateFilter) Match(template *Template) bool {
+	if f.Name != "" && !strings.Contains(strings.ToLower(template.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.Lang != "" && !strings.EqualFold(template.Lang, f.Lang) {
+		return false
+	}
+	if f.Category != "" && !matchTemplateCategory(template, f.Category) {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !hasTemplateTag(template, tag) {
+			return false
+		}
+	}
+	created := template.Created.Time()
+	if !f.CreatedFrom.IsZero() && created.Before(f.CreatedFrom) {
+		return false
+	}
+	if !f.CreatedTo.IsZero() && !created.Before(f.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+// GetAllTemplates returns all templates of the owner reading them page by page
+func (service *TemplatesService) GetAllTemplates(ctx context.Context, owner string) ([]*Template, error) {
+	var templates []*Template
+	for offset := 0; ; offset += templatesPageSize {
+		page, err := service.GetTemplates(ctx, templatesPageSize, offset, owner)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, page...)
+		if len(page) < templatesPageSize {
+			return templates, nil
+		}
+	}
+}
+
+// SearchTemplates returns templates satisfying the filter
+func (service *TemplatesService) SearchTemplates(ctx context.Context, filter TemplateFilter) ([]*Template, error) {
+	templates, err := service.GetAllTemplates(ctx, filter.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Template
+	for _, template := range templates {
+		if filter.Match(template) {
+			result = append(result, template)
+		}
+	}
+	return result, nil
+}
+
+// GetTemplateCategories returns categories of the owner's templates ordered by their sort index
+func (service *TemplatesService) GetTemplateCategories(ctx context.Context, owner string) ([]*TemplateCategory, error) {
+	templates, err := service.GetAllTemplates(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []*TemplateCategory
+	seen := make(map[int]bool)
+	for _, template := range templates {
+		if template.CategoryInfo == nil || seen[template.CategoryInfo.ID] {
+			continue
+		}
+		seen[template.CategoryInfo.ID] = true
+		categories = append(categories, template.CategoryInfo)
+	}
+	sort.SliceStable(categories, func(i, j int) bool {
+		return categories[i].Sort < categories[j].Sort
+	})
+	return categories, nil
+}
+
+// DeleteTemplates removes templates satisfying the filter and returns removed ones.
+// Only the user's own templates can be removed, so the filter owner is always "me".
+// An empty filter is refused unless options.All is set
+func (service *TemplatesService) DeleteTemplates(ctx context.Context, filter TemplateFilter, options DeleteTemplatesOptions) ([]*Template, error) {
+	if filter.IsEmpty() && !options.All {
+		return nil, fmt.Errorf("template filter is empty: set All to delete all templates")
+	}
+	filter.Owner = "me"
+	templates, err := service.SearchTemplates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if options.DryRun {
+		return templates, nil
+	}
+
+	deleted := make([]*Template, 0, len(templates))
+	for _, template := range templates {
+		if err := service.DeleteTemplate(ctx, template.RealID); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, template)
+	}
+	return deleted, nil
+}
+
+func matchTemplateCategory(template *Template, category string) bool {
+	if strings.EqualFold(template.Category, category) {
+		return true
+	}
+	info := template.CategoryInfo
+	return info != nil && (strings.EqualFold(info.Code, category) || strings.EqualFold(info.Name, category))
+}
+
+func hasTemplateTag(template *Template, tag string) bool {
+	for key, value := range template.Tags {
+		if strings.EqualFold(key, tag) || strings.EqualFold(value, tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const templatesSearchFixture = `[
+	{
+		"id": "a1", "real_id": 1, "lang": "en", "name": "Webinar Speakers", "owner": "me",
+		"created": "2020-09-04 13:54:30", "category": "education",
+		"category_info": {"id": 109, "name": "Education", "code": "education", "sort": 2},
+		"tags": {"webinar": "webinar", "invite": "invite"}
+	},
+	{
+		"id": "a2", "real_id": 2, "lang": "ru", "name": "Old promo", "owner": "me",
+		"created": "2019-01-10 10:00:00", "category": "ecommerce",
+		"category_info": {"id": 110, "name": "E-commerce", "code": "ecommerce", "sort": 1},
+		"tags": {"sale": "sale"}
+	},
+	{
+		"id": "a3", "real_id": 3, "lang": "en", "name": "Old webinar", "owner": "me",
+		"created": "2019-02-10 10:00:00", "category": "education",
+		"category_info": {"id": 109, "name": "Education", "code": "education", "sort": 2},
+		"tags": {"webinar": "webinar"}
+	}
+]`
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_Delete() {
+	suite.mux.HandleFunc("/template/1", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodDelete, r.Method)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+
+	err := suite.client.Emails.Templates.DeleteTemplate(context.Background(), 1)
+	suite.NoError(err)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_Search() {
+	suite.mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("me", r.URL.Query().Get("owner"))
+		suite.Equal("0", r.URL.Query().Get("offset"))
+		fmt.Fprintf(w, templatesSearchFixture)
+	})
+
+	templates, err := suite.client.Emails.Templates.SearchTemplates(context.Background(), TemplateFilter{
+		Owner:    "me",
+		Name:     "webinar",
+		Lang:     "EN",
+		Tags:     []string{"webinar"},
+		Category: "Education",
+	})
+	suite.NoError(err)
+	suite.Len(templates, 2)
+
+	templates, err = suite.client.Emails.Templates.SearchTemplates(context.Background(), TemplateFilter{
+		Owner:       "me",
+		CreatedFrom: time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC),
+		CreatedTo:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	suite.NoError(err)
+	suite.Len(templates, 1)
+	suite.Equal(3, templates[0].RealID)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_Categories() {
+	suite.mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, templatesSearchFixture)
+	})
+
+	categories, err := suite.client.Emails.Templates.GetTemplateCategories(context.Background(), "me")
+	suite.NoError(err)
+	suite.Len(categories, 2)
+	suite.Equal("ecommerce", categories[0].Code)
+	suite.Equal("education", categories[1].Code)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_DeleteTemplates() {
+	suite.mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, templatesSearchFixture)
+	})
+	var deleted []string
+	suite.mux.HandleFunc("/template/", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodDelete, r.Method)
+		deleted = append(deleted, r.URL.Path)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+
+	filter := TemplateFilter{CreatedTo: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	templates, err := suite.client.Emails.Templates.DeleteTemplates(context.Background(), filter, DeleteTemplatesOptions{DryRun: true})
+	suite.NoError(err)
+	suite.Len(templates, 2)
+	suite.Empty(deleted)
+
+	templates, err = suite.client.Emails.Templates.DeleteTemplates(context.Background(), filter, DeleteTemplatesOptions{})
+	suite.NoError(err)
+	suite.Len(templates, 2)
+	suite.Equal([]string{"/template/2", "/template/3"}, deleted)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_DeleteTemplatesEmptyFilter() {
+	suite.mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, templatesSearchFixture)
+	})
+	suite.mux.HandleFunc("/template/", func(w http.ResponseWriter, r *http.Request) {
+		suite.Fail("templates must not be deleted")
+	})
+
+	_, err := suite.client.Emails.Templates.DeleteTemplates(context.Background(), TemplateFilter{Owner: "me"}, DeleteTemplatesOptions{})
+	suite.Error(err)
+
+	templates, err := suite.client.Emails.Templates.DeleteTemplates(context.Background(), TemplateFilter{}, DeleteTemplatesOptions{All: true, DryRun: true})
+	suite.NoError(err)
+	suite.NotEmpty(templates)
+}
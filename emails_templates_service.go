@@ -120,3 +120,14 @@ func (service *TemplatesService) GetTemplates(ctx context.Context, limit, offset
 	_, err := service.client.newRequest(ctx, http.MethodGet, path, nil, &respData, true)
 	return respData, err
 }
+
+// DeleteTemplate removes a template created by the user
+func (service *TemplatesService) DeleteTemplate(ctx context.Context, templateID int) error {
+	path := fmt.Sprintf("/template/%d", templateID)
+
+	var response struct {
+		Result bool `json:"result"`
+	}
+	_, err := service.client.newRequest(ctx, http.MethodDelete, path, nil, &response, true)
+	return err
+}
@@ -0,0 +1,295 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultTemplateManifestName is the name of the manifest file created in the templates directory
+const DefaultTemplateManifestName = "sendpulse-templates.json"
+
+const defaultTemplateLang = "en"
+
+// TemplateSyncAction is an action performed with a local template during the sync
+type TemplateSyncAction string
+
+const (
+	TemplateSyncCreate    TemplateSyncAction = "create"
+	TemplateSyncUpdate    TemplateSyncAction = "update"
+	TemplateSyncUnchanged TemplateSyncAction = "unchanged"
+)
+
+// LocalTemplate represents a template read from a local directory.
+// Metadata is read from an optional JSON file next to the HTML file, e.g. welcome.json for welcome.html
+type LocalTemplate struct {
+	LocalName string `json:"-"`
+	Path      string `json:"-"`
+	Name      string `json:"name"`
+	Lang      string `json:"lang"`
+	Body      string `json:"-"`
+	Hash      string `json:"-"`
+}
+
+// TemplateManifestEntry describes a synced template
+type TemplateManifestEntry struct {
+	RealID   int       `json:"real_id"`
+	Name     string    `json:"name"`
+	Lang     string    `json:"lang"`
+	Hash     string    `json:"hash"`
+	SyncedAt time.Time `json:"synced_at"`
+}
+
+// TemplateManifest maps local template names to SendPulse templates
+type TemplateManifest struct {
+	Templates map[string]*TemplateManifestEntry `json:"templates"`
+}
+
+// LoadTemplateManifest reads a manifest file. A missing file results in an empty manifest
+func LoadTemplateManifest(path string) (*TemplateManifest, error) {
+	manifest := &TemplateManifest{Templates: make(map[string]*TemplateManifestEntry)}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("invalid template manifest %s: %w", path, err)
+	}
+	if manifest.Templates == nil {
+		manifest.Templates = make(map[string]*TemplateManifestEntry)
+	}
+	return manifest, nil
+}
+
+// Save writes the manifest to a file
+func (m *TemplateManifest) Save(path string) error {
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// TemplateSyncParams describes parameters of the templates sync
+type TemplateSyncParams struct {
+	Dir          string // Directory with *.html files
+	Lang         string // Language of templates without metadata. Without it existing templates keep their language and new ones are created in "en"
+	ManifestPath string // Path of the manifest file (default: DefaultTemplateManifestName inside Dir)
+	DryRun       bool   // Only compute changes without uploading templates and writing the manifest
+}
+
+// TemplateSyncChange describes a sync action of a local template
+type TemplateSyncChange struct {
+	Template *LocalTemplate
+	Action   TemplateSyncAction
+	RealID   int
+	OldHash  string
+}
+
+// TemplateSyncResult represents a result of the templates sync
+type TemplateSyncResult struct {
+	DryRun   bool
+	Changes  []*TemplateSyncChange
+	Manifest *TemplateManifest
+}
+
+// Diff returns a human-readable list of changes
+func (r *TemplateSyncResult) Diff() string {
+	var sb strings.Builder
+	for _, change := range r.Changes {
+		switch change.Action {
+		case TemplateSyncCreate:
+			fmt.Fprintf(&sb, "+ %s (%s)\n", change.Template.LocalName, change.Template.Name)
+		case TemplateSyncUpdate:
+			fmt.Fprintf(&sb, "~ %s (%s) #%d %s -> %s\n", change.Template.LocalName, change.Template.Name,
+				change.RealID, shortHash(change.OldHash), shortHash(change.Template.Hash))
+		default:
+			fmt.Fprintf(&sb, "  %s (%s) #%d\n", change.Template.LocalName, change.Template.Name, change.RealID)
+		}
+	}
+	return sb.String()
+}
+
+// Changed returns changes which create or update templates
+func (r *TemplateSyncResult) Changed() []*TemplateSyncChange {
+	var changed []*TemplateSyncChange
+	for _, change := range r.Changes {
+		if change.Action != TemplateSyncUnchanged {
+			changed = append(changed, change)
+		}
+	}
+	return changed
+}
+
+// ReadTemplateDir reads HTML templates and their metadata from a directory ordered by file name.
+// Lang is left empty for templates without a language in metadata if lang is empty
+func ReadTemplateDir(dir string, lang string) ([]*LocalTemplate, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	templates := make([]*LocalTemplate, 0, len(paths))
+	for _, path := range paths {
+		body, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		localName := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		template := &LocalTemplate{
+			LocalName: localName,
+			Path:      path,
+			Name:      localName,
+			Lang:      lang,
+			Body:      string(body),
+		}
+
+		meta, err := ioutil.ReadFile(strings.TrimSuffix(path, filepath.Ext(path)) + ".json")
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(meta, template); err != nil {
+				return nil, fmt.Errorf("invalid metadata of template %s: %w", localName, err)
+			}
+		}
+		template.Hash = template.hash()
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// SyncTemplates uploads templates from a local directory. Templates are matched to existing ones by the manifest
+// or by name and are created or updated only when the hash of their name, language and body changed.
+// An error is returned if a template without a manifest entry matches several templates by name
+func (service *TemplatesService) SyncTemplates(ctx context.Context, params TemplateSyncParams) (*TemplateSyncResult, error) {
+	manifestPath := params.ManifestPath
+	if manifestPath == "" {
+		manifestPath = filepath.Join(params.Dir, DefaultTemplateManifestName)
+	}
+	manifest, err := LoadTemplateManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	locals, err := ReadTemplateDir(params.Dir, params.Lang)
+	if err != nil {
+		return nil, err
+	}
+	remotes, err := service.GetAllTemplates(ctx, "me")
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*Template, len(remotes))
+	byName := make(map[string][]*Template, len(remotes))
+	for _, remote := range remotes {
+		byID[remote.RealID] = remote
+		byName[remote.Name] = append(byName[remote.Name], remote)
+	}
+
+	result := &TemplateSyncResult{DryRun: params.DryRun, Manifest: manifest}
+	for _, local := range locals {
+		change := &TemplateSyncChange{Template: local, Action: TemplateSyncCreate}
+		entry := manifest.Templates[local.LocalName]
+
+		var remote *Template
+		if entry != nil {
+			remote = byID[entry.RealID]
+		}
+		if remote == nil {
+			entry = nil
+			switch named := byName[local.Name]; len(named) {
+			case 0:
+			case 1:
+				remote = named[0]
+			default:
+				return result, fmt.Errorf("template %s: name %q matches %d templates, add the template to the manifest", local.LocalName, local.Name, len(named))
+			}
+		}
+		if local.Lang == "" {
+			local.Lang = defaultTemplateLang
+			if remote != nil && remote.Lang != "" {
+				local.Lang = remote.Lang
+			}
+			local.Hash = local.hash()
+		}
+		if remote != nil {
+			change.RealID = remote.RealID
+			if entry != nil {
+				change.OldHash = entry.Hash
+			} else if change.OldHash, err = service.remoteTemplateHash(ctx, remote); err != nil {
+				return nil, err
+			}
+			change.Action = TemplateSyncUpdate
+			if change.OldHash == local.Hash {
+				change.Action = TemplateSyncUnchanged
+			}
+		}
+		result.Changes = append(result.Changes, change)
+
+		if params.DryRun || (change.Action == TemplateSyncUnchanged && entry != nil) {
+			continue
+		}
+		switch change.Action {
+		case TemplateSyncCreate:
+			if change.RealID, err = service.CreateTemplate(ctx, local.Name, local.Body, local.Lang); err != nil {
+				return result, err
+			}
+		case TemplateSyncUpdate:
+			if err := service.UpdateTemplate(ctx, change.RealID, local.Body, local.Lang); err != nil {
+				return result, err
+			}
+		}
+		manifest.Templates[local.LocalName] = &TemplateManifestEntry{
+			RealID:   change.RealID,
+			Name:     local.Name,
+			Lang:     local.Lang,
+			Hash:     local.Hash,
+			SyncedAt: time.Now().UTC(),
+		}
+		if err := manifest.Save(manifestPath); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// remoteTemplateHash returns the content hash of an existing template loading its body if the list omits it
+func (service *TemplatesService) remoteTemplateHash(ctx context.Context, template *Template) (string, error) {
+	if template.Body == "" {
+		loaded, err := service.GetTemplate(ctx, template.RealID)
+		if err != nil {
+			return "", err
+		}
+		template = loaded
+	}
+	return templateHash(template.Name, template.Lang, template.DecodedBody()), nil
+}
+
+func (t *LocalTemplate) hash() string {
+	return templateHash(t.Name, t.Lang, t.Body)
+}
+
+func templateHash(name, lang, body string) string {
+	sum := sha256.Sum256([]byte(name + "\x00" + lang + "\x00" + body))
+	return hex.EncodeToString(sum[:])
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 8 {
+		return hash[:8]
+	}
+	return hash
+}
@@ -0,0 +1,86 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_Sync() {
+	dir, err := ioutil.TempDir("", "templates")
+	suite.NoError(err)
+	defer os.RemoveAll(dir)
+	suite.NoError(ioutil.WriteFile(filepath.Join(dir, "welcome.html"), []byte("<h1>Welcome</h1>"), 0600))
+	suite.NoError(ioutil.WriteFile(filepath.Join(dir, "welcome.json"), []byte(`{"name": "Welcome email", "lang": "ru"}`), 0600))
+	suite.NoError(ioutil.WriteFile(filepath.Join(dir, "promo.html"), []byte("<h1>Promo v2</h1>"), 0600))
+	suite.NoError(ioutil.WriteFile(filepath.Join(dir, "news.html"), []byte("<h1>News</h1>"), 0600))
+
+	suite.mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[
+			{"id": "a1", "real_id": 1, "name": "promo", "lang": "en", "owner": "me", "body": "%s"},
+			{"id": "a2", "real_id": 2, "name": "news", "lang": "ru", "owner": "me", "body": "%s"}
+		]`, b64.StdEncoding.EncodeToString([]byte("<h1>Promo</h1>")), b64.StdEncoding.EncodeToString([]byte("<h1>News</h1>")))
+	})
+	created, updated := 0, 0
+	suite.mux.HandleFunc("/template", func(w http.ResponseWriter, r *http.Request) {
+		created++
+		fmt.Fprintf(w, `{"result": true, "real_id": 3}`)
+	})
+	suite.mux.HandleFunc("/template/edit/1", func(w http.ResponseWriter, r *http.Request) {
+		updated++
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+
+	params := TemplateSyncParams{Dir: dir, DryRun: true}
+	result, err := suite.client.Emails.Templates.SyncTemplates(context.Background(), params)
+	suite.NoError(err)
+	suite.Len(result.Changed(), 2)
+	suite.Contains(result.Diff(), "+ welcome (Welcome email)")
+	suite.Contains(result.Diff(), "~ promo (promo) #1")
+	suite.Equal(0, created+updated)
+	_, err = os.Stat(filepath.Join(dir, DefaultTemplateManifestName))
+	suite.True(os.IsNotExist(err))
+
+	params.DryRun = false
+	_, err = suite.client.Emails.Templates.SyncTemplates(context.Background(), params)
+	suite.NoError(err)
+	suite.Equal(1, created)
+	suite.Equal(1, updated)
+
+	manifest, err := LoadTemplateManifest(filepath.Join(dir, DefaultTemplateManifestName))
+	suite.NoError(err)
+	suite.Equal(3, manifest.Templates["welcome"].RealID)
+	suite.Equal("ru", manifest.Templates["welcome"].Lang)
+	suite.Equal(1, manifest.Templates["promo"].RealID)
+	suite.Equal(2, manifest.Templates["news"].RealID)
+	suite.Equal("ru", manifest.Templates["news"].Lang)
+	suite.Equal("en", manifest.Templates["promo"].Lang)
+
+	suite.NoError(ioutil.WriteFile(filepath.Join(dir, "promo.json"), []byte(`{"name": "promo", "lang": "ru"}`), 0600))
+	result, err = suite.client.Emails.Templates.SyncTemplates(context.Background(), params)
+	suite.NoError(err)
+	suite.Contains(result.Diff(), "~ promo (promo) #1")
+	suite.Contains(result.Diff(), "  news (news) #2")
+	suite.Equal(2, updated)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_TemplatesService_SyncDuplicateNames() {
+	dir, err := ioutil.TempDir("", "templates")
+	suite.NoError(err)
+	defer os.RemoveAll(dir)
+	suite.NoError(ioutil.WriteFile(filepath.Join(dir, "news.html"), []byte("<h1>News</h1>"), 0600))
+
+	suite.mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[
+			{"id": "a1", "real_id": 1, "name": "news", "lang": "en", "owner": "me"},
+			{"id": "a2", "real_id": 2, "name": "news", "lang": "ru", "owner": "me"}
+		]`)
+	})
+
+	_, err = suite.client.Emails.Templates.SyncTemplates(context.Background(), TemplateSyncParams{Dir: dir, DryRun: true})
+	suite.Error(err)
+}
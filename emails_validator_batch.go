@@ -0,0 +1,232 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultValidationMaxPolls = 60
+
+// EmailValidationCache stores results of single email validations
+type EmailValidationCache interface {
+	// Get returns a result or nil if it isn't cached
+	Get(email string) *EmailValidationResult
+	Set(email string, result *EmailValidationResult)
+}
+
+type cachedEmailValidation struct {
+	result    *EmailValidationResult
+	expiresAt time.Time
+}
+
+// MemoryEmailValidationCache is an in-memory EmailValidationCache keeping results for a TTL
+type MemoryEmailValidationCache struct {
+	ttl     time.Duration
+	lock    sync.Mutex
+	results map[string]cachedEmailValidation
+}
+
+// NewMemoryEmailValidationCache creates MemoryEmailValidationCache
+func NewMemoryEmailValidationCache(ttl time.Duration) *MemoryEmailValidationCache {
+	return &MemoryEmailValidationCache{ttl: ttl, results: make(map[string]cachedEmailValidation)}
+}
+
+// Get returns a cached result or nil if it isn't found or expired
+func (c *MemoryEmailValidationCache) Get(email string) *EmailValidationResult {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	key := strings.ToLower(email)
+	cached, ok := c.results[key]
+	if ok && time.Now().After(cached.expiresAt) {
+		delete(c.results, key)
+		return nil
+	}
+	return cached.result
+}
+
+// Set stores a result for the TTL
+func (c *MemoryEmailValidationCache) Set(email string, result *EmailValidationResult) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.results[strings.ToLower(email)] = cachedEmailValidation{result: result, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// EmailSyntaxError is returned for malformed email addresses
+type EmailSyntaxError struct {
+	Email  string
+	Reason string
+}
+
+// Error returns string representation of the EmailSyntaxError
+func (e *EmailSyntaxError) Error() string {
+	return fmt.Sprintf("invalid email %q: %s", e.Email, e.Reason)
+}
+
+// CheckEmailSyntax checks an email address locally without DNS requests
+func CheckEmailSyntax(email string) error {
+	fail := func(reason string) error {
+		return &EmailSyntaxError{Email: email, Reason: reason}
+	}
+
+	if len(email) > 254 {
+		return fail("address is too long")
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || address.Name != "" {
+		return fail("address is malformed")
+	}
+
+	at := strings.LastIndex(email, "@")
+	local, domain := email[:at], email[at+1:]
+	if len(local) > 64 {
+		return fail("local part is too long")
+	}
+	if strings.HasPrefix(local, ".") || strings.HasSuffix(local, ".") || strings.Contains(local, "..") {
+		return fail("local part has misplaced dots")
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return fail("domain has no top-level domain")
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fail("domain is malformed")
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r > 127) {
+				return fail("domain is malformed")
+			}
+		}
+	}
+	if tld := labels[len(labels)-1]; len(tld) < 2 {
+		return fail("top-level domain is too short")
+	}
+	return nil
+}
+
+// ValidateEmailsOptions describes options of ValidateEmails
+type ValidateEmailsOptions struct {
+	// Cache provides known results and stores new ones
+	Cache EmailValidationCache
+	// PollInterval is an interval between result requests (default: 5 seconds)
+	PollInterval time.Duration
+	// MaxPolls limits the number of result requests per address (default: 60)
+	MaxPolls int
+}
+
+// EmailValidationOutcome represents a validation outcome of a single address
+type EmailValidationOutcome struct {
+	Email  string
+	Result *EmailValidationResult
+	// Cached is true if the result was taken from the cache
+	Cached bool
+	// Error is EmailSyntaxError for malformed addresses, an error of the API
+	// or an error if the result isn't ready after MaxPolls requests
+	Error error
+}
+
+// ValidateEmails validates addresses skipping malformed ones and taking known results from the cache.
+// Other addresses are submitted for validation and polled until every one has a result or MaxPolls is reached.
+// Outcomes are returned in the order of unique addresses
+func (service *ValidatorService) ValidateEmails(ctx context.Context, emails []string, options ValidateEmailsOptions) ([]*EmailValidationOutcome, error) {
+	outcomes := make([]*EmailValidationOutcome, 0, len(emails))
+	seen := make(map[string]bool, len(emails))
+	var pending []*EmailValidationOutcome
+
+	for _, email := range emails {
+		email = strings.TrimSpace(email)
+		key := strings.ToLower(email)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		outcome := &EmailValidationOutcome{Email: email}
+		outcomes = append(outcomes, outcome)
+		if outcome.Error = CheckEmailSyntax(email); outcome.Error != nil {
+			continue
+		}
+
+		if options.Cache != nil {
+			if result := options.Cache.Get(email); result != nil {
+				outcome.Result, outcome.Cached = result, true
+				continue
+			}
+		}
+
+		if outcome.Error = service.ValidateEmail(ctx, email); outcome.Error != nil {
+			if ctx.Err() != nil {
+				return outcomes, ctx.Err()
+			}
+			continue
+		}
+		pending = append(pending, outcome)
+	}
+
+	interval := options.PollInterval
+	if interval <= 0 {
+		interval = defaultValidationInitialInterval
+	}
+	maxPolls := options.MaxPolls
+	if maxPolls <= 0 {
+		maxPolls = defaultValidationMaxPolls
+	}
+	for polls := 0; len(pending) != 0; polls++ {
+		if polls == maxPolls {
+			for _, outcome := range pending {
+				outcome.Error = fmt.Errorf("no validation result of %s after %d requests", outcome.Email, maxPolls)
+			}
+			return outcomes, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			for _, outcome := range pending {
+				outcome.Error = ctx.Err()
+			}
+			return outcomes, ctx.Err()
+		case <-timer.C:
+		}
+
+		var err error
+		if pending, err = service.pollEmailValidations(ctx, pending, options.Cache); err != nil {
+			return outcomes, err
+		}
+	}
+	return outcomes, nil
+}
+
+// pollEmailValidations requests results of pending addresses and returns ones which are still pending.
+// API errors are final for the address
+func (service *ValidatorService) pollEmailValidations(ctx context.Context, pending []*EmailValidationOutcome, cache EmailValidationCache) ([]*EmailValidationOutcome, error) {
+	var still []*EmailValidationOutcome
+	for _, outcome := range pending {
+		result, err := service.GetEmailValidationResult(ctx, outcome.Email)
+		var apiErr *SendpulseError
+		if err != nil && !errors.As(err, &apiErr) {
+			return nil, err
+		}
+		if err != nil {
+			outcome.Error = err
+			continue
+		}
+		if result == nil || !result.Checks.Status.IsFinal() {
+			still = append(still, outcome)
+			continue
+		}
+
+		outcome.Result = result
+		if cache != nil {
+			cache.Set(outcome.Email, result)
+		}
+	}
+	return still, nil
+}
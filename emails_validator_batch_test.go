@@ -0,0 +1,98 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestCheckEmailSyntax() {
+	for _, email := range []string{"alex@sendpulse.com", "a.b+c@mail.co.uk", "user@пример.рф"} {
+		suite.NoError(CheckEmailSyntax(email), email)
+	}
+	for _, email := range []string{"", "alex", "alex@", "@sendpulse.com", "alex@sendpulse", "a..b@test.com",
+		".a@test.com", "alex@-test.com", "alex@test..com", "Alex <alex@test.com>", "alex@test.c", "alex@te_st.com"} {
+		suite.IsType(&EmailSyntaxError{}, CheckEmailSyntax(email), email)
+	}
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_ValidatorService_ValidateEmails() {
+	submitted := make(map[string]int)
+	suite.mux.HandleFunc("/verifier-service/send-single-to-verify/", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Email string `json:"email"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		submitted[body.Email]++
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	polls := make(map[string]int)
+	suite.mux.HandleFunc("/verifier-service/get-single-result/", func(w http.ResponseWriter, r *http.Request) {
+		email := r.URL.Query().Get("email")
+		polls[email]++
+		status := 0
+		if polls[email] > 1 {
+			status = 1
+			if email == "bad@test.com" {
+				status = 3
+			}
+		}
+		fmt.Fprintf(w, `{"result": true, "data": {"email": "%s", "checks": {"status": %d}}}`, email, status)
+	})
+
+	validator := suite.client.Emails.Validator
+	options := ValidateEmailsOptions{Cache: NewMemoryEmailValidationCache(time.Hour), PollInterval: time.Millisecond}
+
+	emails := []string{"good@test.com", "bad@test.com", "GOOD@test.com", "broken@"}
+	outcomes, err := validator.ValidateEmails(context.Background(), emails, options)
+	suite.NoError(err)
+	suite.Len(outcomes, 3)
+	suite.Equal(EmailValidationStatusValid, outcomes[0].Result.Checks.Status)
+	suite.Equal(EmailValidationStatusInvalid, outcomes[1].Result.Checks.Status)
+	suite.IsType(&EmailSyntaxError{}, outcomes[2].Error)
+	suite.Nil(outcomes[2].Result)
+	suite.Len(submitted, 2)
+
+	outcomes, err = validator.ValidateEmails(context.Background(), []string{"good@test.com"}, options)
+	suite.NoError(err)
+	suite.True(outcomes[0].Cached)
+	suite.Equal(1, submitted["good@test.com"])
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_ValidatorService_ValidateEmailsErrors() {
+	suite.mux.HandleFunc("/verifier-service/send-single-to-verify/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	polls := make(map[string]int)
+	suite.mux.HandleFunc("/verifier-service/get-single-result/", func(w http.ResponseWriter, r *http.Request) {
+		email := r.URL.Query().Get("email")
+		polls[email]++
+		if email == "missing@test.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"result": false, "message": "Not found"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"result": true, "data": null}`)
+	})
+
+	outcomes, err := suite.client.Emails.Validator.ValidateEmails(context.Background(), []string{"missing@test.com", "a+b@test.com"},
+		ValidateEmailsOptions{PollInterval: time.Millisecond, MaxPolls: 3})
+	suite.NoError(err)
+	suite.IsType(&SendpulseError{}, outcomes[0].Error)
+	suite.Equal(1, polls["missing@test.com"])
+	suite.Error(outcomes[1].Error)
+	suite.Nil(outcomes[1].Result)
+	suite.Equal(3, polls["a+b@test.com"])
+}
+
+func (suite *SendpulseTestSuite) TestMemoryEmailValidationCache() {
+	cache := NewMemoryEmailValidationCache(10 * time.Millisecond)
+	cache.Set("Alex@test.com", &EmailValidationResult{Email: "alex@test.com"})
+	suite.NotNil(cache.Get("alex@test.com"))
+	suite.Nil(cache.Get("other@test.com"))
+
+	time.Sleep(20 * time.Millisecond)
+	suite.Nil(cache.Get("alex@test.com"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -71,11 +72,11 @@ type MailingListValidationResult struct {
 type MailingListValidationResultDetailed struct {
 	MailingListValidationResult
 	EmailAddresses []struct {
-		ID           int          `json:"id"`
-		EmailAddress string       `json:"email_address"`
-		CheckDate    DateTimeType `json:"check_date"`
-		Status       int          `json:"status"`
-		StatusText   string       `json:"status_text"`
+		ID           int                   `json:"id"`
+		EmailAddress string                `json:"email_address"`
+		CheckDate    DateTimeType          `json:"check_date"`
+		Status       EmailValidationStatus `json:"status"`
+		StatusText   string                `json:"status_text"`
 	} `json:"email_addresses"`
 	EmailAddressesTotal int `json:"email_addresses_total"`
 }
@@ -117,18 +118,18 @@ func (service *ValidatorService) ValidateEmail(ctx context.Context, email string
 type EmailValidationResult struct {
 	Email  string `json:"email"`
 	Checks struct {
-		Status      int    `json:"status"`
-		ValidFormat int    `json:"valid_format"`
-		Disposable  int    `json:"disposable"`
-		Webmail     int    `json:"webmail"`
-		Gibberish   int    `json:"gibberish"`
-		StatusText  string `json:"status_text"`
+		Status      EmailValidationStatus `json:"status"`
+		ValidFormat int                   `json:"valid_format"`
+		Disposable  int                   `json:"disposable"`
+		Webmail     int                   `json:"webmail"`
+		Gibberish   int                   `json:"gibberish"`
+		StatusText  string                `json:"status_text"`
 	} `json:"checks"`
 }
 
 // GetEmailValidationResult returns the results of a verification of specific email
 func (service *ValidatorService) GetEmailValidationResult(ctx context.Context, email string) (*EmailValidationResult, error) {
-	path := fmt.Sprintf("/verifier-service/get-single-result/?email=%s", email)
+	path := fmt.Sprintf("/verifier-service/get-single-result/?email=%s", url.QueryEscape(email))
 	var response struct {
 		Result bool                   `json:"result"`
 		Data   *EmailValidationResult `json:"data"`
@@ -187,7 +188,7 @@ func (service *ValidatorService) CreateMailingListValidationReport(ctx context.C
 		body.Statuses = "[" + strings.Join(strStatuses, ",") + "]"
 	}
 
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, params, &response, true)
+	_, err := service.client.newRequest(ctx, http.MethodPost, path, body, &response, true)
 	return err
 }
 
@@ -0,0 +1,209 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultValidationInitialInterval = 5 * time.Second
+	defaultValidationMaxInterval     = time.Minute
+	defaultValidationMaxStalledPolls = 30
+)
+
+// InvalidEmailsAction is an action performed with invalid addresses after the validation
+type InvalidEmailsAction string
+
+const (
+	InvalidEmailsKeep        InvalidEmailsAction = ""
+	InvalidEmailsDelete      InvalidEmailsAction = "delete"
+	InvalidEmailsUnsubscribe InvalidEmailsAction = "unsubscribe"
+)
+
+// ValidateAndWaitOptions describes options of ValidateAndWait
+type ValidateAndWaitOptions struct {
+	// InitialInterval is the first interval between progress requests (default: 5 seconds)
+	InitialInterval time.Duration
+	// MaxInterval limits the interval which doubles while the progress doesn't change (default: 1 minute)
+	MaxInterval time.Duration
+	// MaxStalledPolls limits progress requests in a row without processed addresses (default: 30)
+	MaxStalledPolls int
+	// OnProgress is called after every progress request
+	OnProgress func(progress ValidationProgress)
+	// InvalidAction defines what to do with addresses having InvalidStatuses
+	InvalidAction InvalidEmailsAction
+	// InvalidStatuses lists statuses of addresses to delete or unsubscribe (default: EmailValidationStatusInvalid)
+	InvalidStatuses []EmailValidationStatus
+	// BatchSize is the max number of emails per delete or unsubscribe request (default: 100)
+	BatchSize int
+	// Report creates a report with the results if set. Its ID is filled automatically
+	Report *MailingListReportParams
+}
+
+// ValidationStalledError is returned when the validation progress doesn't change within MaxStalledPolls
+type ValidationStalledError struct {
+	MailingListID int
+	Processed     int
+	Total         int
+	Polls         int
+}
+
+// Error returns string representation of the ValidationStalledError
+func (e *ValidationStalledError) Error() string {
+	return fmt.Sprintf("validation of mailing list %d is stalled at %d of %d addresses after %d polls",
+		e.MailingListID, e.Processed, e.Total, e.Polls)
+}
+
+// ValidateAndWaitResult represents a result of ValidateAndWait
+type ValidateAndWaitResult struct {
+	Result *MailingListValidationResultDetailed
+	// Invalid lists addresses having InvalidStatuses
+	Invalid []string
+	// Processed lists invalid addresses which were deleted or unsubscribed
+	Processed []string
+}
+
+// ValidateAndWait starts a mailing list validation, waits for its completion and returns the detailed result.
+// ValidationStalledError is returned if the progress doesn't change within options.MaxStalledPolls requests
+func (service *ValidatorService) ValidateAndWait(ctx context.Context, mailingListID int, options ValidateAndWaitOptions) (*ValidateAndWaitResult, error) {
+	if err := service.ValidateMailingList(ctx, mailingListID); err != nil {
+		return nil, err
+	}
+	if err := service.waitForValidation(ctx, mailingListID, options); err != nil {
+		return nil, err
+	}
+
+	detailed, err := service.GetMailingListValidationResult(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	result := &ValidateAndWaitResult{Result: detailed}
+
+	statuses := options.InvalidStatuses
+	if len(statuses) == 0 {
+		statuses = []EmailValidationStatus{EmailValidationStatusInvalid}
+	}
+	for _, address := range detailed.EmailAddresses {
+		for _, status := range statuses {
+			if address.Status == status {
+				result.Invalid = append(result.Invalid, address.EmailAddress)
+				break
+			}
+		}
+	}
+
+	if err := service.processInvalidEmails(ctx, mailingListID, result, options); err != nil {
+		return result, err
+	}
+
+	if options.Report != nil {
+		params := *options.Report
+		params.ID = mailingListID
+		if err := service.CreateMailingListValidationReport(ctx, params); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// waitForValidation polls the validation progress until all addresses are processed
+func (service *ValidatorService) waitForValidation(ctx context.Context, mailingListID int, options ValidateAndWaitOptions) error {
+	interval := options.InitialInterval
+	if interval <= 0 {
+		interval = defaultValidationInitialInterval
+	}
+	maxInterval := options.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultValidationMaxInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+	maxStalled := options.MaxStalledPolls
+	if maxStalled <= 0 {
+		maxStalled = defaultValidationMaxStalledPolls
+	}
+
+	delay := interval
+	processed := -1
+	stalled := 0
+	for {
+		progress, err := service.GetMailingListValidationProgress(ctx, mailingListID)
+		if err != nil {
+			return err
+		}
+		if progress == nil {
+			return fmt.Errorf("no validation progress of mailing list %d", mailingListID)
+		}
+		if options.OnProgress != nil {
+			options.OnProgress(*progress)
+		}
+		if progress.Total > 0 && progress.Processed >= progress.Total {
+			return nil
+		}
+		if progress.Total == 0 {
+			// the progress is empty both before the validation starts and for an empty mailing list,
+			// so the result tells whether there is anything to validate
+			result, err := service.GetMailingListValidationResult(ctx, mailingListID)
+			if err != nil {
+				return err
+			}
+			if result != nil && result.AllEmailsQuantity == 0 {
+				return nil
+			}
+		}
+		if progress.Processed != processed {
+			processed = progress.Processed
+			delay = interval
+			stalled = 0
+		} else {
+			stalled++
+			if stalled >= maxStalled {
+				return &ValidationStalledError{
+					MailingListID: mailingListID,
+					Processed:     progress.Processed,
+					Total:         progress.Total,
+					Polls:         stalled,
+				}
+			}
+			delay *= 2
+			if delay > maxInterval {
+				delay = maxInterval
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (service *ValidatorService) processInvalidEmails(ctx context.Context, mailingListID int, result *ValidateAndWaitResult, options ValidateAndWaitOptions) error {
+	var process func(context.Context, int, []string) error
+	switch options.InvalidAction {
+	case InvalidEmailsDelete:
+		process = service.client.Emails.MailingLists.DeleteMailingListEmails
+	case InvalidEmailsUnsubscribe:
+		process = service.client.Emails.MailingLists.UnsubscribeEmails
+	default:
+		return nil
+	}
+
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSyncBatchSize
+	}
+	for start := 0; start < len(result.Invalid); start += batchSize {
+		batch := result.Invalid[start:minInt(start+batchSize, len(result.Invalid))]
+		if err := process(ctx, mailingListID, batch); err != nil {
+			return err
+		}
+		result.Processed = append(result.Processed, batch...)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestEmailsService_ValidatorService_ValidateAndWait() {
+	suite.mux.HandleFunc("/verifier-service/send-list-to-verify/", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	processed := []int{0, 0, 1, 3}
+	requests := 0
+	suite.mux.HandleFunc("/verifier-service/get-progress/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "data": {"total": 3, "processed": %d}}`, processed[requests])
+		requests++
+	})
+	suite.mux.HandleFunc("/verifier-service/check/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{
+			"id": 1,
+			"all_emails_quantity": 3,
+			"email_addresses": [
+				{"id": 1, "email_address": "valid@test.com", "status": 1},
+				{"id": 2, "email_address": "invalid@test.com", "status": 3},
+				{"id": 3, "email_address": "unconfirmed@test.com", "status": 2}
+			]
+		}`)
+	})
+	var deleted []string
+	suite.mux.HandleFunc("/addressbooks/1/emails", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodDelete, r.Method)
+		var body struct {
+			Emails []string `json:"emails"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		deleted = append(deleted, body.Emails...)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/verifier-service/make-report", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		suite.Equal(1.0, body["id"])
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+
+	var progress []ValidationProgress
+	result, err := suite.client.Emails.Validator.ValidateAndWait(context.Background(), 1, ValidateAndWaitOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+		OnProgress: func(p ValidationProgress) {
+			progress = append(progress, p)
+		},
+		InvalidAction: InvalidEmailsDelete,
+		Report:        &MailingListReportParams{Lang: "en"},
+	})
+	suite.NoError(err)
+	suite.Len(progress, 4)
+	suite.Equal(3, result.Result.AllEmailsQuantity)
+	suite.Equal([]string{"invalid@test.com"}, result.Invalid)
+	suite.Equal([]string{"invalid@test.com"}, result.Processed)
+	suite.Equal([]string{"invalid@test.com"}, deleted)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_ValidatorService_ValidateAndWait_Timeout() {
+	suite.mux.HandleFunc("/verifier-service/send-list-to-verify/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/verifier-service/get-progress/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "data": {"total": 3, "processed": 1}}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := suite.client.Emails.Validator.ValidateAndWait(ctx, 1, ValidateAndWaitOptions{InitialInterval: time.Millisecond})
+	suite.Error(err)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_ValidatorService_ValidateAndWait_Empty() {
+	suite.mux.HandleFunc("/verifier-service/send-list-to-verify/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/verifier-service/get-progress/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "data": {"total": 0, "processed": 0}}`)
+	})
+	suite.mux.HandleFunc("/verifier-service/check/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "all_emails_quantity": 0, "email_addresses": []}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result, err := suite.client.Emails.Validator.ValidateAndWait(ctx, 1, ValidateAndWaitOptions{InitialInterval: time.Millisecond})
+	suite.NoError(err)
+	suite.Empty(result.Invalid)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_ValidatorService_ValidateAndWait_NoProgress() {
+	suite.mux.HandleFunc("/verifier-service/send-list-to-verify/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/verifier-service/get-progress/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": false}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := suite.client.Emails.Validator.ValidateAndWait(ctx, 1, ValidateAndWaitOptions{InitialInterval: time.Millisecond})
+	suite.Error(err)
+	suite.NotEqual(context.DeadlineExceeded, err)
+}
+
+func (suite *SendpulseTestSuite) TestEmailsService_ValidatorService_ValidateAndWait_Stalled() {
+	suite.mux.HandleFunc("/verifier-service/send-list-to-verify/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	requests := 0
+	suite.mux.HandleFunc("/verifier-service/get-progress/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"result": true, "data": {"total": 3, "processed": 1}}`)
+	})
+
+	_, err := suite.client.Emails.Validator.ValidateAndWait(context.Background(), 1, ValidateAndWaitOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		MaxStalledPolls: 3,
+	})
+	suite.IsType(&ValidationStalledError{}, err)
+	suite.Equal(1, err.(*ValidationStalledError).Processed)
+	suite.Equal(4, requests)
+}
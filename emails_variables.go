@@ -0,0 +1,286 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// VariableDateFormat is the format of date variables expected by SendPulse
+const VariableDateFormat = "2006-01-02"
+
+// variableDateLayouts lists accepted formats of date variables
+var variableDateLayouts = []string{VariableDateFormat, dtFormat, time.RFC3339, "02.01.2006"}
+
+// VariableTypeError is returned when a value doesn't match the type of a variable
+type VariableTypeError struct {
+	Name  string
+	Type  VariableType
+	Value interface{}
+}
+
+// Error returns string representation of the VariableTypeError
+func (e *VariableTypeError) Error() string {
+	return fmt.Sprintf("value %v (%T) doesn't match %s variable %q", e.Value, e.Value, e.Type, e.Name)
+}
+
+// VariableSchema converts variable values of a mailing list according to their types.
+// Variables missing in the schema are passed as is
+type VariableSchema struct {
+	variables map[string]*VariableMeta
+	location  *time.Location
+}
+
+// NewVariableSchema creates VariableSchema. Dates are converted in the location of the account (default: UTC)
+func NewVariableSchema(variables []*VariableMeta, loc *time.Location) *VariableSchema {
+	if loc == nil {
+		loc = time.UTC
+	}
+	schema := &VariableSchema{variables: make(map[string]*VariableMeta, len(variables)), location: loc}
+	for _, variable := range variables {
+		schema.variables[strings.ToLower(variable.Name)] = variable
+	}
+	return schema
+}
+
+// GetVariableSchema loads the variable schema of a mailing list
+func (service *MailingListsService) GetVariableSchema(ctx context.Context, mailingListID int) (*VariableSchema, error) {
+	variables, err := service.GetMailingListVariables(ctx, mailingListID)
+	if err != nil {
+		return nil, err
+	}
+	return NewVariableSchema(variables, service.client.Location()), nil
+}
+
+// Lookup returns a variable by its name
+func (s *VariableSchema) Lookup(name string) (*VariableMeta, bool) {
+	variable, ok := s.variables[strings.ToLower(name)]
+	return variable, ok
+}
+
+// EncodeValue converts a Go value to the format of the variable.
+// Strings accept any scalar value, numbers accept numbers, numeric strings and bools,
+// dates accept time.Time, DateTimeType and date strings
+func (s *VariableSchema) EncodeValue(name string, value interface{}) (interface{}, error) {
+	variable, ok := s.Lookup(name)
+	if !ok || value == nil {
+		return value, nil
+	}
+
+	switch variable.Type {
+	case VariableTypeString:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case time.Time, DateTimeType:
+			return encodeDateValue(v, s.location)
+		case fmt.Stringer:
+			return v.String(), nil
+		}
+		if _, err := numberValue(value); err == nil {
+			return fmt.Sprint(value), nil
+		}
+		if v, ok := value.(bool); ok {
+			return strconv.FormatBool(v), nil
+		}
+	case VariableTypeNumber:
+		if v, ok := value.(bool); ok {
+			if v {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		}
+		if number, err := numberValue(value); err == nil {
+			return number, nil
+		}
+	case VariableTypeDate:
+		if date, err := encodeDateValue(value, s.location); err == nil {
+			return date, nil
+		}
+	default:
+		return value, nil
+	}
+	return nil, &VariableTypeError{Name: variable.Name, Type: variable.Type, Value: value}
+}
+
+// DecodeValue converts a value received from SendPulse to string, int64, float64 or time.Time according to the variable type.
+// Dates are parsed in the schema location, the same one EncodeValue formats them in
+func (s *VariableSchema) DecodeValue(name string, value interface{}) (interface{}, error) {
+	variable, ok := s.Lookup(name)
+	if !ok || value == nil {
+		return value, nil
+	}
+
+	switch variable.Type {
+	case VariableTypeString:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+		return fmt.Sprint(value), nil
+	case VariableTypeNumber:
+		if v, ok := value.(string); ok && v == "" {
+			return nil, nil
+		}
+		if number, err := numberValue(value); err == nil {
+			return number, nil
+		}
+	case VariableTypeDate:
+		if v, ok := value.(string); ok && v == "" {
+			return nil, nil
+		}
+		if date, err := dateValue(value, s.location); err == nil {
+			return date, nil
+		}
+	default:
+		return value, nil
+	}
+	return nil, &VariableTypeError{Name: variable.Name, Type: variable.Type, Value: value}
+}
+
+// Encode converts Go values of variables to the format expected by SendPulse
+func (s *VariableSchema) Encode(values map[string]interface{}) (map[string]interface{}, error) {
+	return s.convert(values, s.EncodeValue)
+}
+
+// Decode converts variable values received from SendPulse to typed values
+func (s *VariableSchema) Decode(values map[string]interface{}) (map[string]interface{}, error) {
+	return s.convert(values, s.DecodeValue)
+}
+
+// EmailToAdd creates EmailToAdd with encoded variables
+func (s *VariableSchema) EmailToAdd(email string, values map[string]interface{}) (*EmailToAdd, error) {
+	variables, err := s.Encode(values)
+	if err != nil {
+		return nil, err
+	}
+	return &EmailToAdd{Email: email, Variables: variables}, nil
+}
+
+// Variables encodes values to a list of variables ordered by name, e.g. for UpdateEmailVariables
+func (s *VariableSchema) Variables(values map[string]interface{}) ([]*Variable, error) {
+	encoded, err := s.Encode(values)
+	if err != nil {
+		return nil, err
+	}
+	variables := make([]*Variable, 0, len(encoded))
+	for name, value := range encoded {
+		variable := &Variable{Name: name, Value: value}
+		if meta, ok := s.Lookup(name); ok {
+			variable.Type = meta.Type
+		}
+		variables = append(variables, variable)
+	}
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+	return variables, nil
+}
+
+func (s *VariableSchema) convert(values map[string]interface{}, fn func(string, interface{}) (interface{}, error)) (map[string]interface{}, error) {
+	if values == nil {
+		return nil, nil
+	}
+	result := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		converted, err := fn(name, value)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = converted
+	}
+	return result, nil
+}
+
+// numberValue converts numeric values and numeric strings to int64 or float64. Integral numbers are always int64
+func numberValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return numberValue(uint64(v))
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return nil, fmt.Errorf("%v is out of range", value)
+		}
+		return int64(v), nil
+	case float32:
+		return numberValue(float64(v))
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%v is not a finite number", value)
+		}
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return int64(v), nil
+		}
+		return v, nil
+	case json.Number:
+		return numberValue(v.String())
+	case string:
+		s := strings.TrimSpace(v)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		return numberValue(f)
+	}
+	return nil, fmt.Errorf("%v is not a number", value)
+}
+
+// encodeDateValue converts a date value to the format of date variables in the location.
+// Dates are formatted in their own location if loc is nil
+func encodeDateValue(value interface{}, loc *time.Location) (string, error) {
+	date, err := dateValue(value, loc)
+	if err != nil {
+		return "", err
+	}
+	if loc != nil {
+		date = date.In(loc)
+	}
+	return date.Format(VariableDateFormat), nil
+}
+
+// dateValue converts dates and date strings to time.Time. Date strings are parsed in the location (default: UTC)
+func dateValue(value interface{}, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case DateTimeType:
+		return v.Time(), nil
+	case *DateTimeType:
+		if v != nil {
+			return v.Time(), nil
+		}
+	case string:
+		for _, layout := range variableDateLayouts {
+			if date, err := time.ParseInLocation(layout, strings.TrimSpace(v), loc); err == nil {
+				return date, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("%v is not a date", value)
+}
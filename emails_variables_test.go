@@ -0,0 +1,90 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestVariableSchema_Encode() {
+	schema := NewVariableSchema([]*VariableMeta{
+		{Name: "Name", Type: VariableTypeString},
+		{Name: "age", Type: VariableTypeNumber},
+		{Name: "vip", Type: VariableTypeNumber},
+		{Name: "birthday", Type: VariableTypeDate},
+	}, nil)
+
+	encoded, err := schema.Encode(map[string]interface{}{
+		"name":     "Alex",
+		"age":      "30",
+		"vip":      true,
+		"birthday": time.Date(1990, 5, 17, 15, 0, 0, 0, time.UTC),
+		"city":     "Kyiv",
+	})
+	suite.NoError(err)
+	suite.Equal(map[string]interface{}{
+		"name":     "Alex",
+		"age":      int64(30),
+		"vip":      int64(1),
+		"birthday": "1990-05-17",
+		"city":     "Kyiv",
+	}, encoded)
+
+	_, err = schema.Encode(map[string]interface{}{"birthday": "yesterday"})
+	suite.IsType(&VariableTypeError{}, err)
+	_, err = schema.Encode(map[string]interface{}{"age": time.Now()})
+	suite.IsType(&VariableTypeError{}, err)
+	for _, value := range []interface{}{uint64(math.MaxUint64), math.NaN(), math.Inf(1), float32(math.Inf(-1)), "NaN"} {
+		_, err = schema.EncodeValue("age", value)
+		suite.IsType(&VariableTypeError{}, err, value)
+	}
+
+	variables, err := schema.Variables(map[string]interface{}{"age": 5.5, "birthday": "17.05.1990"})
+	suite.NoError(err)
+	suite.Equal("age", variables[0].Name)
+	suite.Equal(VariableTypeNumber, variables[0].Type)
+	suite.Equal("1990-05-17", variables[1].Value)
+}
+
+func (suite *SendpulseTestSuite) TestVariableSchema_Decode() {
+	suite.handleMailingListVariables()
+
+	schema, err := suite.client.Emails.MailingLists.GetVariableSchema(context.Background(), 1)
+	suite.NoError(err)
+
+	var email Email
+	suite.NoError(json.Unmarshal([]byte(`{"variables": {"Name": "Alex", "age": "30", "birthday": "1990-05-17"}}`), &email))
+	decoded, err := schema.Decode(email.Variables)
+	suite.NoError(err)
+	suite.Equal("Alex", decoded["Name"])
+	suite.Equal(int64(30), decoded["age"])
+	suite.Equal(time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC), decoded["birthday"])
+
+	_, err = schema.DecodeValue("age", "thirty")
+	suite.IsType(&VariableTypeError{}, err)
+	_, err = schema.DecodeValue("birthday", 42.0)
+	suite.IsType(&VariableTypeError{}, err)
+
+	value, err := schema.DecodeValue("age", 12.5)
+	suite.NoError(err)
+	suite.Equal(12.5, value)
+	for _, number := range []interface{}{30.0, float32(30), "3e1", json.Number("30.0")} {
+		value, err = schema.DecodeValue("age", number)
+		suite.NoError(err)
+		suite.Equal(int64(30), value, number)
+	}
+}
+
+func (suite *SendpulseTestSuite) TestVariableSchema_DateLocation() {
+	schema := NewVariableSchema([]*VariableMeta{{Name: "birthday", Type: VariableTypeDate}}, time.FixedZone("UTC+3", 3*60*60))
+
+	date := time.Date(1990, 5, 16, 22, 0, 0, 0, time.UTC)
+	encoded, err := schema.EncodeValue("birthday", date)
+	suite.NoError(err)
+	suite.Equal("1990-05-17", encoded)
+
+	decoded, err := schema.DecodeValue("birthday", encoded)
+	suite.NoError(err)
+	suite.Equal(time.Date(1990, 5, 16, 21, 0, 0, 0, time.UTC), decoded.(time.Time).UTC())
+}
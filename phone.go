@@ -0,0 +1,286 @@
+package sendpulse_sdk_go
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	minPhoneDigits = 8
+	maxPhoneDigits = 15
+)
+
+// PhoneCountry describes the dialing rules of a country
+type PhoneCountry struct {
+	CallingCode string
+	TrunkPrefix string
+	MinLength   int // Min length of a national significant number
+	MaxLength   int // Max length of a national significant number
+}
+
+// PhoneCountries contains dialing rules by ISO 3166-1 alpha-2 country code
+var PhoneCountries = map[string]PhoneCountry{
+	"AM": {"374", "0", 8, 8},
+	"AT": {"43", "0", 7, 13},
+	"AU": {"61", "0", 9, 9},
+	"AZ": {"994", "0", 9, 9},
+	"BE": {"32", "0", 8, 9},
+	"BG": {"359", "0", 8, 9},
+	"BR": {"55", "0", 10, 11},
+	"BY": {"375", "80", 9, 9},
+	"CA": {"1", "1", 10, 10},
+	"CH": {"41", "0", 9, 9},
+	"CN": {"86", "0", 10, 11},
+	"CZ": {"420", "", 9, 9},
+	"DE": {"49", "0", 7, 11},
+	"DK": {"45", "", 8, 8},
+	"EE": {"372", "", 7, 8},
+	"ES": {"34", "", 9, 9},
+	"FI": {"358", "0", 6, 10},
+	"FR": {"33", "0", 9, 9},
+	"GB": {"44", "0", 9, 10},
+	"GE": {"995", "0", 9, 9},
+	"GR": {"30", "", 10, 10},
+	"IE": {"353", "0", 7, 9},
+	"IL": {"972", "0", 8, 9},
+	"IN": {"91", "0", 10, 10},
+	"IT": {"39", "", 6, 11},
+	"JP": {"81", "0", 9, 10},
+	"KG": {"996", "0", 9, 9},
+	"KZ": {"7", "8", 10, 10},
+	"LT": {"370", "8", 8, 8},
+	"LV": {"371", "", 8, 8},
+	"MD": {"373", "0", 8, 8},
+	"NL": {"31", "0", 9, 9},
+	"NO": {"47", "", 8, 8},
+	"PL": {"48", "", 9, 9},
+	"PT": {"351", "", 9, 9},
+	"RO": {"40", "0", 9, 9},
+	"RU": {"7", "8", 10, 10},
+	"SE": {"46", "0", 7, 9},
+	"SK": {"421", "0", 9, 9},
+	"TJ": {"992", "", 9, 9},
+	"TM": {"993", "8", 8, 8},
+	"TR": {"90", "0", 10, 10},
+	"UA": {"380", "0", 9, 9},
+	"US": {"1", "1", 10, 10},
+	"UZ": {"998", "", 9, 9},
+}
+
+// callingCodes contains assigned ITU-T E.164 country calling codes
+var callingCodes = makeCallingCodes(
+	"1 7 20 27 30 31 32 33 34 36 39 40 41 43 44 45 46 47 48 49 51 52 53 54 55 56 57 58 60 61 62 63 64 65 66 81 82 84 86 " +
+		"90 91 92 93 94 95 98 211 212 213 216 218 220 221 222 223 224 225 226 227 228 229 230 231 232 233 234 235 236 237 " +
+		"238 239 240 241 242 243 244 245 246 247 248 249 250 251 252 253 254 255 256 257 258 260 261 262 263 264 265 266 " +
+		"267 268 269 290 291 297 298 299 350 351 352 353 354 355 356 357 358 359 370 371 372 373 374 375 376 377 378 379 " +
+		"380 381 382 383 385 386 387 389 420 421 423 500 501 502 503 504 505 506 507 508 509 590 591 592 593 594 595 596 " +
+		"597 598 599 670 672 673 674 675 676 677 678 679 680 681 682 683 685 686 687 688 689 690 691 692 850 852 853 855 " +
+		"856 880 886 960 961 962 963 964 965 966 967 968 970 971 972 973 974 975 976 977 992 993 994 995 996 998",
+)
+
+// PhoneError describes a phone number which can't be normalized
+type PhoneError struct {
+	Phone  string
+	Reason string
+}
+
+// Error returns string representation of the PhoneError
+func (e *PhoneError) Error() string {
+	return fmt.Sprintf("invalid phone %q: %s", e.Phone, e.Reason)
+}
+
+// PhonesError contains all phone numbers of a list which can't be normalized
+type PhonesError struct {
+	Errors []*PhoneError
+}
+
+// Error returns string representation of the PhonesError
+func (e *PhonesError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// NormalizePhone converts a phone number to E.164 format (e.g. +380501234567).
+// Numbers without an international prefix are treated as national numbers of defaultCountry (ISO 3166-1 alpha-2).
+// If defaultCountry is empty, such numbers are treated as international ones
+func NormalizePhone(phone string, defaultCountry string) (string, error) {
+	digits, international, err := cleanPhone(phone)
+	if err != nil {
+		return "", err
+	}
+
+	if !international && defaultCountry != "" {
+		country, ok := PhoneCountries[strings.ToUpper(defaultCountry)]
+		if !ok {
+			return "", &PhoneError{Phone: phone, Reason: fmt.Sprintf("unknown country %q", defaultCountry)}
+		}
+		digits = applyPhoneCountry(digits, country)
+	}
+
+	if len(digits) < minPhoneDigits || len(digits) > maxPhoneDigits {
+		return "", &PhoneError{Phone: phone, Reason: fmt.Sprintf("must contain from %d to %d digits", minPhoneDigits, maxPhoneDigits)}
+	}
+
+	code := phoneCallingCode(digits)
+	if code == "" {
+		return "", &PhoneError{Phone: phone, Reason: "unknown country calling code"}
+	}
+	for _, country := range PhoneCountries {
+		if country.CallingCode != code {
+			continue
+		}
+		length := len(digits) - len(code)
+		if length < country.MinLength || length > country.MaxLength {
+			return "", &PhoneError{Phone: phone, Reason: fmt.Sprintf("invalid length for calling code +%s", code)}
+		}
+		break
+	}
+
+	return "+" + digits, nil
+}
+
+// NormalizePhones converts phone numbers to E.164 format and removes duplicates keeping the original order.
+// Invalid numbers are reported in *PhonesError and skipped
+func NormalizePhones(phones []string, defaultCountry string) ([]string, error) {
+	result := make([]string, 0, len(phones))
+	seen := make(map[string]bool, len(phones))
+	var phonesErr PhonesError
+	for _, phone := range phones {
+		normalized, err := NormalizePhone(phone, defaultCountry)
+		if err != nil {
+			phonesErr.Errors = append(phonesErr.Errors, err.(*PhoneError))
+			continue
+		}
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		result = append(result, normalized)
+	}
+
+	if len(phonesErr.Errors) != 0 {
+		return result, &phonesErr
+	}
+	return result, nil
+}
+
+// IsValidPhone reports whether the phone number can be normalized
+func IsValidPhone(phone string, defaultCountry string) bool {
+	_, err := NormalizePhone(phone, defaultCountry)
+	return err == nil
+}
+
+// preparePhone normalizes a phone number to the SendPulse format (E.164 without a plus sign)
+// if phone normalization is enabled in Config
+func (c *Client) preparePhone(phone string) (string, error) {
+	if !c.config.NormalizePhones {
+		return phone, nil
+	}
+	normalized, err := NormalizePhone(phone, c.config.DefaultPhoneCountry)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(normalized, "+"), nil
+}
+
+// preparePhones normalizes and de-duplicates phone numbers if phone normalization is enabled in Config
+func (c *Client) preparePhones(phones []string) ([]string, error) {
+	if !c.config.NormalizePhones {
+		return phones, nil
+	}
+	normalized, err := NormalizePhones(phones, c.config.DefaultPhoneCountry)
+	if err != nil {
+		return nil, err
+	}
+	for i, phone := range normalized {
+		normalized[i] = strings.TrimPrefix(phone, "+")
+	}
+	return normalized, nil
+}
+
+// prepareIntPhones normalizes and de-duplicates phone numbers represented as integers
+func (c *Client) prepareIntPhones(phones []int) ([]int, error) {
+	if !c.config.NormalizePhones {
+		return phones, nil
+	}
+	strPhones := make([]string, len(phones))
+	for i, phone := range phones {
+		strPhones[i] = strconv.Itoa(phone)
+	}
+	strPhones, err := c.preparePhones(strPhones)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]int, len(strPhones))
+	for i, phone := range strPhones {
+		result[i], _ = strconv.Atoi(phone)
+	}
+	return result, nil
+}
+
+// cleanPhone removes formatting characters and international prefix from a phone number
+func cleanPhone(phone string) (string, bool, error) {
+	trimmed := strings.TrimSpace(phone)
+	international := false
+	switch {
+	case strings.HasPrefix(trimmed, "+"):
+		international = true
+		trimmed = trimmed[1:]
+	case strings.HasPrefix(trimmed, "00"):
+		international = true
+		trimmed = trimmed[2:]
+	}
+
+	var sb strings.Builder
+	for _, r := range trimmed {
+		switch {
+		case r >= '0' && r <= '9':
+			sb.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return "", false, &PhoneError{Phone: phone, Reason: fmt.Sprintf("unexpected character %q", r)}
+		}
+	}
+
+	if sb.Len() == 0 {
+		return "", false, &PhoneError{Phone: phone, Reason: "empty phone"}
+	}
+	return sb.String(), international, nil
+}
+
+// applyPhoneCountry converts a national phone number to the international one
+func applyPhoneCountry(digits string, country PhoneCountry) string {
+	withCode := len(digits) - len(country.CallingCode)
+	if strings.HasPrefix(digits, country.CallingCode) && withCode >= country.MinLength && withCode <= country.MaxLength {
+		return digits
+	}
+
+	withTrunk := len(digits) - len(country.TrunkPrefix)
+	if country.TrunkPrefix != "" && strings.HasPrefix(digits, country.TrunkPrefix) && withTrunk >= country.MinLength && withTrunk <= country.MaxLength {
+		return country.CallingCode + digits[len(country.TrunkPrefix):]
+	}
+
+	return country.CallingCode + digits
+}
+
+// phoneCallingCode returns the country calling code of an international phone number
+func phoneCallingCode(digits string) string {
+	for length := 1; length <= 3 && length <= len(digits); length++ {
+		if callingCodes[digits[:length]] {
+			return digits[:length]
+		}
+	}
+	return ""
+}
+
+func makeCallingCodes(codes string) map[string]bool {
+	set := make(map[string]bool)
+	for _, code := range strings.Fields(codes) {
+		set[code] = true
+	}
+	return set
+}
@@ -0,0 +1,135 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (suite *SendpulseTestSuite) TestPhone_Normalize() {
+	cases := []struct {
+		phone   string
+		country string
+		result  string
+	}{
+		{"+38 (050) 123-45-67", "", "+380501234567"},
+		{"00380501234567", "", "+380501234567"},
+		{"380501234567", "", "+380501234567"},
+		{"050 123 45 67", "UA", "+380501234567"},
+		{"501234567", "ua", "+380501234567"},
+		{"380501234567", "UA", "+380501234567"},
+		{"8 (921) 745-12-32", "RU", "+79217451232"},
+		{"79217451232", "RU", "+79217451232"},
+		{"(202) 555-0143", "US", "+12025550143"},
+	}
+	for _, c := range cases {
+		phone, err := NormalizePhone(c.phone, c.country)
+		suite.NoError(err, c.phone)
+		suite.Equal(c.result, phone, c.phone)
+	}
+}
+
+func (suite *SendpulseTestSuite) TestPhone_NormalizeInvalid() {
+	cases := []struct {
+		phone   string
+		country string
+	}{
+		{"", ""},
+		{"+380abc", ""},
+		{"12345", ""},
+		{"+3805012345678", ""},
+		{"+8881234567", ""},
+		{"0501234567", "XX"},
+	}
+	for _, c := range cases {
+		_, err := NormalizePhone(c.phone, c.country)
+		suite.Error(err, c.phone)
+		suite.IsType(&PhoneError{}, err)
+	}
+	suite.False(IsValidPhone("12345", ""))
+}
+
+func (suite *SendpulseTestSuite) TestPhone_NormalizeList() {
+	phones, err := NormalizePhones([]string{"0501234567", "+380501234567", "bad", "0671234567"}, "UA")
+	suite.Error(err)
+	suite.Len(err.(*PhonesError).Errors, 1)
+	suite.Equal([]string{"+380501234567", "+380671234567"}, phones)
+}
+
+func (suite *SendpulseTestSuite) TestSmsService_AddPhonesNormalized() {
+	suite.client.config.NormalizePhones = true
+	suite.client.config.DefaultPhoneCountry = "UA"
+
+	suite.mux.HandleFunc("/sms/numbers", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Phones []string `json:"phones"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		suite.Equal([]string{"380501234567"}, body.Phones)
+		fmt.Fprintf(w, `{"result": true, "counters": {"added": 1, "exceptions": 0, "exists": 0}}`)
+	})
+
+	counters, err := suite.client.SMS.AddPhones(context.Background(), 1, []string{"050 123 45 67", "+380501234567"})
+	suite.NoError(err)
+	suite.Equal(1, counters.Added)
+
+	_, err = suite.client.SMS.AddPhones(context.Background(), 1, []string{"not a phone"})
+	suite.Error(err)
+}
+
+func (suite *SendpulseTestSuite) TestSmsService_BlacklistAndDeleteNormalized() {
+	suite.client.config.NormalizePhones = true
+	suite.client.config.DefaultPhoneCountry = "UA"
+
+	phonesHandler := func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Phones []string `json:"phones"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		suite.Equal([]string{"380931112233"}, body.Phones)
+		fmt.Fprintf(w, `{"result": true}`)
+	}
+	suite.mux.HandleFunc("/sms/numbers", phonesHandler)
+	suite.mux.HandleFunc("/sms/black_list", phonesHandler)
+	suite.mux.HandleFunc("/sms/black_list/by_numbers", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("[380931112233]", r.URL.Query().Get("phones"))
+		fmt.Fprintf(w, `{"result": true, "data": [{"phone": 380931112233, "description": "", "add_date": "2021-06-18 19:57:39"}]}`)
+	})
+	suite.mux.HandleFunc("/sms/numbers/info/1/380931112233", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "data": {"status": 0, "variables": {}, "added": "2021-06-18 19:57:39"}}`)
+	})
+
+	phone := "+38 (093) 111-22-33"
+	suite.NoError(suite.client.SMS.DeletePhones(context.Background(), 1, []string{phone}))
+	suite.NoError(suite.client.SMS.AddToBlacklist(context.Background(), []string{phone}, ""))
+	suite.NoError(suite.client.SMS.RemoveFromBlacklist(context.Background(), []string{phone}))
+	blacklisted, err := suite.client.SMS.GetBlacklistedPhones(context.Background(), []string{phone})
+	suite.NoError(err)
+	suite.Equal("380931112233", blacklisted[0].Phone)
+	info, err := suite.client.SMS.GetPhoneInfo(context.Background(), 1, phone)
+	suite.NoError(err)
+	suite.NotNil(info)
+
+	suite.IsType(&PhonesError{}, suite.client.SMS.AddToBlacklist(context.Background(), []string{"bad"}, ""))
+}
+
+func (suite *SendpulseTestSuite) TestBotsWhatsAppService_SendByPhoneNormalized() {
+	suite.client.config.NormalizePhones = true
+	suite.client.config.DefaultPhoneCountry = "UA"
+
+	suite.mux.HandleFunc("/whatsapp/contacts/sendByPhone", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Phone string `json:"phone"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		suite.Equal("380501234567", body.Phone)
+		fmt.Fprintf(w, `{"success": true}`)
+	})
+
+	message := &WhatsAppMessage{Type: "text"}
+	suite.NoError(suite.client.Bots.WhatsApp.SendByPhone(context.Background(), "bot", "050 123 45 67", message))
+
+	err := suite.client.Bots.WhatsApp.SendByPhone(context.Background(), "bot", "123", message)
+	suite.IsType(&PhoneError{}, err)
+}
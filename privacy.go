@@ -0,0 +1,417 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BotChannel is a messenger of chatbots
+type BotChannel string
+
+const (
+	BotChannelFb       BotChannel = "fb"
+	BotChannelVk       BotChannel = "vk"
+	BotChannelTelegram BotChannel = "telegram"
+	BotChannelWhatsApp BotChannel = "whatsapp"
+	BotChannelIg       BotChannel = "ig"
+	BotChannelLiveChat BotChannel = "live_chat"
+)
+
+// Channels of erasure actions
+const (
+	ErasureChannelEmail      = "email"
+	ErasureChannelBlacklist  = "email_blacklist"
+	ErasureChannelSmtp       = "smtp"
+	ErasureChannelValidation = "email_validation"
+	ErasureChannelSms        = "sms"
+)
+
+// PersonIdentity describes identifiers of a person
+type PersonIdentity struct {
+	Email string
+	Phone string
+	// SmsMailingListIDs lists SMS mailing lists to look for the phone in
+	SmsMailingListIDs []int
+	// BotContacts lists contact IDs of chatbots per channel
+	BotContacts map[BotChannel][]string
+}
+
+// subjectHash returns a hash of the identifiers which can be kept in audit records
+func (p *PersonIdentity) subjectHash() string {
+	parts := []string{strings.ToLower(strings.TrimSpace(p.Email)), strings.TrimSpace(p.Phone)}
+	for _, channel := range sortedBotChannels(p.BotContacts) {
+		for _, id := range p.BotContacts[channel] {
+			parts = append(parts, string(channel)+":"+id)
+		}
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// PersonLocations describes where the data of a person is stored
+type PersonLocations struct {
+	EmailProfile    *SubscriberProfile                    `json:"email_profile,omitempty"`
+	SmsMailingLists map[int]*PhoneInfo                    `json:"sms_mailing_lists,omitempty"`
+	BotContacts     map[BotChannel]map[string]interface{} `json:"bot_contacts,omitempty"`
+	// Errors contains errors of sources which couldn't be checked
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// ErasureOptions describes options of the erasure
+type ErasureOptions struct {
+	// RemoveSuppressions removes the email from the email blacklist and the SMTP unsubscribe list
+	// and the phone from the SMS blacklist. By default the person is added to the SMTP unsubscribe list
+	// and the SMS blacklist instead, so that they aren't contacted again
+	RemoveSuppressions bool
+	// DryRun only discovers the data and records planned actions
+	DryRun bool
+	// Comment is added to suppression lists
+	Comment string
+	// EmailProfile sets how the SMTP unsubscribe list is checked during the discovery.
+	// RemoveSuppressions requires either SmtpUnsubscribed or CheckSmtpUnsubscribe
+	EmailProfile SubscriberProfileOptions
+}
+
+// ErasureAction describes a single action of the erasure
+type ErasureAction struct {
+	Channel string    `json:"channel"`
+	Target  string    `json:"target"`
+	Action  string    `json:"action"`
+	Planned bool      `json:"planned,omitempty"`
+	Error   string    `json:"error,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// ErasureAudit is an audit record of the erasure. It doesn't contain personal data, only a hash of the identifiers
+type ErasureAudit struct {
+	SubjectHash        string            `json:"subject_hash"`
+	StartedAt          time.Time         `json:"started_at"`
+	FinishedAt         time.Time         `json:"finished_at"`
+	DryRun             bool              `json:"dry_run"`
+	RemoveSuppressions bool              `json:"remove_suppressions"`
+	Actions            []*ErasureAction  `json:"actions"`
+	Errors             map[string]string `json:"discovery_errors,omitempty"`
+}
+
+// Failed returns actions which failed
+func (a *ErasureAudit) Failed() []*ErasureAction {
+	var failed []*ErasureAction
+	for _, action := range a.Actions {
+		if action.Error != "" {
+			failed = append(failed, action)
+		}
+	}
+	return failed
+}
+
+// PersonDataExport is a bundle of all data held about a person
+type PersonDataExport struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	Email       string    `json:"email,omitempty"`
+	Phone       string    `json:"phone,omitempty"`
+	PersonLocations
+}
+
+// JSON returns the export as indented JSON
+func (e *PersonDataExport) JSON() ([]byte, error) {
+	return json.MarshalIndent(e, "", "  ")
+}
+
+// PrivacyManager discovers, exports and erases data of a person across all channels
+type PrivacyManager struct {
+	client       *Client
+	suppressions *SuppressionManager
+	now          func() time.Time
+}
+
+// NewPrivacyManager creates PrivacyManager
+func NewPrivacyManager(client *Client) *PrivacyManager {
+	return &PrivacyManager{client: client, suppressions: NewSuppressionManager(client, SuppressionOptions{}), now: time.Now}
+}
+
+// Discover finds where the data of a person is stored. It takes the requests of GetSubscriberProfile for the email,
+// a request per SMS mailing list and a request per bot contact. The SMTP unsubscribe list is checked only
+// if options.SmtpUnsubscribed is passed or options.CheckSmtpUnsubscribe downloads it
+func (m *PrivacyManager) Discover(ctx context.Context, identity PersonIdentity, options SubscriberProfileOptions) (*PersonLocations, error) {
+	identity, err := m.prepareIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+	locations := &PersonLocations{Errors: make(map[string]string)}
+
+	if identity.Email != "" {
+		profile, err := m.client.Emails.Address.GetSubscriberProfile(ctx, identity.Email, options)
+		var profileErr *SubscriberProfileError
+		if errors.As(err, &profileErr) {
+			for source, sourceErr := range profileErr.Errors {
+				locations.Errors[ErasureChannelEmail+"."+source] = sourceErr.Error()
+			}
+		} else if err != nil {
+			return nil, err
+		}
+		locations.EmailProfile = profile
+	}
+
+	if identity.Phone != "" {
+		for _, bookID := range identity.SmsMailingListIDs {
+			info, err := m.client.SMS.GetPhoneInfo(ctx, bookID, identity.Phone)
+			if isNotFound(err) || (err == nil && info == nil) {
+				continue
+			}
+			if err != nil {
+				locations.Errors[fmt.Sprintf("%s.%d", ErasureChannelSms, bookID)] = err.Error()
+				continue
+			}
+			if locations.SmsMailingLists == nil {
+				locations.SmsMailingLists = make(map[int]*PhoneInfo)
+			}
+			locations.SmsMailingLists[bookID] = info
+		}
+	}
+
+	for _, channel := range sortedBotChannels(identity.BotContacts) {
+		for _, id := range identity.BotContacts[channel] {
+			contact, err := m.getBotContact(ctx, channel, id)
+			if isNotFound(err) {
+				continue
+			}
+			if err != nil {
+				locations.Errors[fmt.Sprintf("%s.%s", channel, id)] = err.Error()
+				continue
+			}
+			if locations.BotContacts == nil {
+				locations.BotContacts = make(map[BotChannel]map[string]interface{})
+			}
+			if locations.BotContacts[channel] == nil {
+				locations.BotContacts[channel] = make(map[string]interface{})
+			}
+			locations.BotContacts[channel][id] = contact
+		}
+	}
+
+	if len(locations.Errors) == 0 {
+		locations.Errors = nil
+	}
+	return locations, nil
+}
+
+// Export collects all data held about a person. It takes the same requests as Discover
+func (m *PrivacyManager) Export(ctx context.Context, identity PersonIdentity, options SubscriberProfileOptions) (*PersonDataExport, error) {
+	locations, err := m.Discover(ctx, identity, options)
+	if err != nil {
+		return nil, err
+	}
+	return &PersonDataExport{
+		GeneratedAt:     m.now().UTC(),
+		Email:           identity.Email,
+		Phone:           identity.Phone,
+		PersonLocations: *locations,
+	}, nil
+}
+
+// Erase deletes the data of a person everywhere it's found and returns the audit record.
+// It takes the requests of Discover and a request per action. Identifiers are validated before anything is deleted.
+// Failed actions are recorded in the audit and don't stop the erasure
+func (m *PrivacyManager) Erase(ctx context.Context, identity PersonIdentity, options ErasureOptions) (*ErasureAudit, error) {
+	if options.RemoveSuppressions && identity.Email != "" &&
+		options.EmailProfile.SmtpUnsubscribed == nil && !options.EmailProfile.CheckSmtpUnsubscribe {
+		return nil, fmt.Errorf("SMTP unsubscribe list isn't checked: set EmailProfile.SmtpUnsubscribed or EmailProfile.CheckSmtpUnsubscribe to remove suppressions")
+	}
+	identity, err := m.prepareIdentity(identity)
+	if err != nil {
+		return nil, err
+	}
+
+	audit := &ErasureAudit{
+		SubjectHash:        identity.subjectHash(),
+		StartedAt:          m.now().UTC(),
+		DryRun:             options.DryRun,
+		RemoveSuppressions: options.RemoveSuppressions,
+	}
+	locations, err := m.Discover(ctx, identity, options.EmailProfile)
+	if err != nil {
+		return audit, err
+	}
+	audit.Errors = locations.Errors
+
+	run := func(channel, target, action string, fn func() error) {
+		record := &ErasureAction{Channel: channel, Target: target, Action: action, Planned: options.DryRun}
+		if !options.DryRun {
+			if err := fn(); err != nil {
+				record.Error = err.Error()
+			}
+		}
+		record.Time = m.now().UTC()
+		audit.Actions = append(audit.Actions, record)
+	}
+
+	if identity.Email != "" {
+		m.eraseEmail(ctx, identity.Email, locations, options, run)
+	}
+
+	if identity.Phone != "" {
+		phone := identity.Phone
+		for _, bookID := range sortedPhoneBookIDs(locations.SmsMailingLists) {
+			bookID := bookID
+			run(ErasureChannelSms, fmt.Sprintf("mailing_list:%d", bookID), "delete", func() error {
+				return m.client.SMS.DeletePhones(ctx, bookID, []string{phone})
+			})
+		}
+		if options.RemoveSuppressions {
+			run(ErasureChannelSms, "blacklist", "remove", func() error {
+				return m.suppressions.Unsuppress(ctx, phone, SuppressionChannelSms)
+			})
+		} else {
+			run(ErasureChannelSms, "blacklist", "add", func() error {
+				return m.suppressions.Suppress(ctx, phone, options.Comment, SuppressionChannelSms)
+			})
+		}
+	}
+
+	for _, channel := range sortedBotChannels(identity.BotContacts) {
+		for _, id := range identity.BotContacts[channel] {
+			if _, ok := locations.BotContacts[channel][id]; !ok {
+				continue
+			}
+			channel, id := channel, id
+			run(string(channel), "contact:"+id, "delete", func() error {
+				return m.deleteBotContact(ctx, channel, id)
+			})
+		}
+	}
+
+	audit.FinishedAt = m.now().UTC()
+	return audit, nil
+}
+
+// prepareIdentity validates and normalizes the identifiers
+func (m *PrivacyManager) prepareIdentity(identity PersonIdentity) (PersonIdentity, error) {
+	identity.Email = normalizeEmail(identity.Email)
+	if identity.Email != "" && !strings.Contains(identity.Email, "@") {
+		return identity, fmt.Errorf("invalid email %q", identity.Email)
+	}
+	if identity.Phone != "" {
+		phone, err := m.client.preparePhone(identity.Phone)
+		if err != nil {
+			return identity, err
+		}
+		identity.Phone = phone
+	}
+	for channel := range identity.BotContacts {
+		switch channel {
+		case BotChannelFb, BotChannelVk, BotChannelTelegram, BotChannelWhatsApp, BotChannelIg, BotChannelLiveChat:
+		default:
+			return identity, fmt.Errorf("unsupported bot channel %q", channel)
+		}
+	}
+	return identity, nil
+}
+
+func (m *PrivacyManager) eraseEmail(ctx context.Context, email string, locations *PersonLocations, options ErasureOptions,
+	run func(channel, target, action string, fn func() error)) {
+	profile := locations.EmailProfile
+	_, infoFailed := locations.Errors[ErasureChannelEmail+"."+ProfileSourceInfo]
+	if infoFailed || (profile != nil && len(profile.MailingLists) != 0) {
+		run(ErasureChannelEmail, "mailing_lists", "delete", func() error {
+			return m.client.Emails.Address.DeleteFromAllAddressBooks(ctx, email)
+		})
+	}
+
+	if profile != nil && profile.Validation != nil {
+		run(ErasureChannelValidation, "result", "delete", func() error {
+			return m.client.Emails.Validator.DeleteEmailValidationResult(ctx, email)
+		})
+	}
+
+	if !options.RemoveSuppressions {
+		if profile == nil || profile.Unsubscribed == nil {
+			run(ErasureChannelSmtp, "unsubscribe", "add", func() error {
+				return m.suppressions.Suppress(ctx, email, options.Comment, SuppressionChannelSmtp)
+			})
+		}
+		return
+	}
+
+	if profile != nil && profile.Blacklisted {
+		run(ErasureChannelBlacklist, "blacklist", "remove", func() error {
+			return m.suppressions.Unsuppress(ctx, email, SuppressionChannelEmail)
+		})
+	}
+	if profile != nil && profile.Unsubscribed != nil {
+		run(ErasureChannelSmtp, "unsubscribe", "remove", func() error {
+			return m.suppressions.Unsuppress(ctx, email, SuppressionChannelSmtp)
+		})
+	}
+}
+
+func (m *PrivacyManager) getBotContact(ctx context.Context, channel BotChannel, id string) (interface{}, error) {
+	bots := m.client.Bots
+	switch channel {
+	case BotChannelFb:
+		return bots.Fb.GetContact(ctx, id)
+	case BotChannelVk:
+		return bots.Vk.GetContact(ctx, id)
+	case BotChannelTelegram:
+		return bots.Telegram.GetContact(ctx, id)
+	case BotChannelWhatsApp:
+		return bots.WhatsApp.GetContact(ctx, id)
+	case BotChannelIg:
+		return bots.Ig.GetContact(ctx, id)
+	case BotChannelLiveChat:
+		return bots.LiveChat.GetContact(ctx, id)
+	}
+	return nil, fmt.Errorf("unsupported bot channel %q", channel)
+}
+
+func (m *PrivacyManager) deleteBotContact(ctx context.Context, channel BotChannel, id string) error {
+	bots := m.client.Bots
+	switch channel {
+	case BotChannelFb:
+		return bots.Fb.DeleteContact(ctx, id)
+	case BotChannelVk:
+		return bots.Vk.DeleteContact(ctx, id)
+	case BotChannelTelegram:
+		return bots.Telegram.DeleteContact(ctx, id)
+	case BotChannelWhatsApp:
+		return bots.WhatsApp.DeleteContact(ctx, id)
+	case BotChannelIg:
+		return bots.Ig.DeleteContact(ctx, id)
+	case BotChannelLiveChat:
+		return bots.LiveChat.DeleteContact(ctx, id)
+	}
+	return fmt.Errorf("unsupported bot channel %q", channel)
+}
+
+// isNotFound reports whether the error is a 404 API error
+func isNotFound(err error) bool {
+	var apiErr *SendpulseError
+	return errors.As(err, &apiErr) && apiErr.HttpCode == http.StatusNotFound
+}
+
+func sortedBotChannels(contacts map[BotChannel][]string) []BotChannel {
+	channels := make([]BotChannel, 0, len(contacts))
+	for channel := range contacts {
+		channels = append(channels, channel)
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i] < channels[j]
+	})
+	return channels
+}
+
+func sortedPhoneBookIDs(m map[int]*PhoneInfo) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
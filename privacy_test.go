@@ -0,0 +1,165 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (suite *SendpulseTestSuite) handlePersonData() map[string]int {
+	calls := make(map[string]int)
+	count := func(name string) { calls[name]++ }
+
+	suite.mux.HandleFunc("/emails/test@sendpulse.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			count("email.delete")
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		fmt.Fprintf(w, `[{"book_id": 1, "status": 1, "variables": [{"name": "name", "value": "Alex"}]}]`)
+	})
+	suite.mux.HandleFunc("/emails/test@sendpulse.com/details", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"list_name": "Customers", "list_id": 1}]`)
+	})
+	suite.mux.HandleFunc("/emails/test@sendpulse.com/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"statistic": {"sent": 1}, "blacklist": true}`)
+	})
+	suite.mux.HandleFunc("/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			count("blacklist.remove")
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		fmt.Fprintf(w, `[]`)
+	})
+	suite.mux.HandleFunc("/smtp/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			count("smtp." + r.Method)
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		fmt.Fprintf(w, `[]`)
+	})
+	suite.mux.HandleFunc("/verifier-service/get-single-result/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "data": {"email": "test@sendpulse.com", "checks": {"status": 1}}}`)
+	})
+	suite.mux.HandleFunc("/verifier-service/delete-single-result", func(w http.ResponseWriter, r *http.Request) {
+		count("validation.delete")
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/sms/numbers/info/1/380931112233", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "data": {"status": 1, "variables": {"name": "Alex"}, "added": "2021-01-01 10:00:00"}}`)
+	})
+	suite.mux.HandleFunc("/sms/numbers/info/2/380931112233", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"result": false, "message": "Phone not found"}`)
+	})
+	suite.mux.HandleFunc("/sms/numbers", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodDelete, r.Method)
+		count("sms.delete")
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/sms/black_list", func(w http.ResponseWriter, r *http.Request) {
+		count("sms_blacklist." + r.Method)
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/telegram/contacts/get", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"success": true, "data": {"id": "%s", "status": 1}}`, r.URL.Query().Get("id"))
+	})
+	suite.mux.HandleFunc("/telegram/contacts/delete", func(w http.ResponseWriter, r *http.Request) {
+		count("telegram.delete")
+		fmt.Fprintf(w, `{"success": true}`)
+	})
+	suite.mux.HandleFunc("/messenger/contacts/get", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"success": false}`)
+	})
+	return calls
+}
+
+var testPersonIdentity = PersonIdentity{
+	Email:             "test@sendpulse.com",
+	Phone:             "380931112233",
+	SmsMailingListIDs: []int{1, 2},
+	BotContacts: map[BotChannel][]string{
+		BotChannelTelegram: {"tg1"},
+		BotChannelFb:       {"fb1"},
+	},
+}
+
+func (suite *SendpulseTestSuite) TestPrivacyManager_Export() {
+	suite.handlePersonData()
+
+	export, err := NewPrivacyManager(suite.client).Export(context.Background(), testPersonIdentity,
+		SubscriberProfileOptions{CheckSmtpUnsubscribe: true})
+	suite.NoError(err)
+	suite.Len(export.EmailProfile.MailingLists, 1)
+	suite.Contains(export.SmsMailingLists, 1)
+	suite.NotContains(export.SmsMailingLists, 2)
+	suite.Contains(export.BotContacts[BotChannelTelegram], "tg1")
+	suite.NotContains(export.BotContacts, BotChannelFb)
+
+	data, err := export.JSON()
+	suite.NoError(err)
+	var decoded map[string]interface{}
+	suite.NoError(json.Unmarshal(data, &decoded))
+	suite.Equal("test@sendpulse.com", decoded["email"])
+	suite.Contains(decoded, "email_profile")
+	suite.Contains(decoded, "bot_contacts")
+}
+
+func (suite *SendpulseTestSuite) TestPrivacyManager_Erase() {
+	calls := suite.handlePersonData()
+	manager := NewPrivacyManager(suite.client)
+
+	audit, err := manager.Erase(context.Background(), testPersonIdentity, ErasureOptions{DryRun: true})
+	suite.NoError(err)
+	suite.NotEmpty(audit.Actions)
+	suite.True(audit.Actions[0].Planned)
+	suite.Empty(calls)
+
+	audit, err = manager.Erase(context.Background(), testPersonIdentity, ErasureOptions{Comment: "GDPR"})
+	suite.NoError(err)
+	for _, action := range audit.Failed() {
+		suite.Fail(action.Channel, action.Error)
+	}
+	suite.Equal(1, calls["email.delete"])
+	suite.Equal(1, calls["validation.delete"])
+	suite.Equal(1, calls["smtp.POST"])
+	suite.Equal(0, calls["blacklist.remove"])
+	suite.Equal(1, calls["sms.delete"])
+	suite.Equal(1, calls["sms_blacklist.POST"])
+	suite.Equal(0, calls["sms_blacklist.DELETE"])
+	suite.Equal(1, calls["telegram.delete"])
+
+	data, err := json.Marshal(audit)
+	suite.NoError(err)
+	suite.False(strings.Contains(string(data), "test@sendpulse.com"))
+	suite.False(strings.Contains(string(data), "380931112233"))
+	suite.Len(audit.SubjectHash, 64)
+
+	_, err = manager.Erase(context.Background(), PersonIdentity{Email: "test@sendpulse.com"}, ErasureOptions{RemoveSuppressions: true})
+	suite.Error(err)
+
+	_, err = manager.Erase(context.Background(), PersonIdentity{Email: "test@sendpulse.com"}, ErasureOptions{
+		RemoveSuppressions: true,
+		EmailProfile:       SubscriberProfileOptions{SmtpUnsubscribed: []Unsubscribed{}},
+	})
+	suite.NoError(err)
+	suite.Equal(1, calls["blacklist.remove"])
+	suite.Equal(1, calls["smtp.POST"])
+}
+
+func (suite *SendpulseTestSuite) TestPrivacyManager_EraseInvalidPhone() {
+	calls := suite.handlePersonData()
+	suite.client.config.NormalizePhones = true
+
+	identity := testPersonIdentity
+	identity.Phone = "bad"
+	audit, err := NewPrivacyManager(suite.client).Erase(context.Background(), identity, ErasureOptions{Comment: "GDPR"})
+	suite.Error(err)
+	suite.Nil(audit)
+	suite.Empty(calls)
+}
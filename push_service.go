@@ -35,7 +35,7 @@ type Push struct {
 	WebsiteID int          `json:"website_id"`
 	From      DateTimeType `json:"from"`
 	To        DateTimeType `json:"to"`
-	Status    int          `json:"status"`
+	Status    PushStatus   `json:"status"`
 }
 
 // GetMessages retrieves a list of sent web push campaigns
@@ -273,12 +273,12 @@ type PushMessagesStatistics struct {
 		Text  string `json:"text"`
 		Link  string `json:"link"`
 	}
-	Website   string `json:"website"`
-	WebsiteID int    `json:"website_id"`
-	Status    int    `json:"status"`
-	Send      int    `json:"send,string"`
-	Delivered int    `json:"delivered"`
-	Redirect  int    `json:"redirect"`
+	Website   string     `json:"website"`
+	WebsiteID int        `json:"website_id"`
+	Status    PushStatus `json:"status"`
+	Send      int        `json:"send,string"`
+	Delivered int        `json:"delivered"`
+	Redirect  int        `json:"redirect"`
 }
 
 // GetPushMessagesStatistics returns statistics on sent campaigns
@@ -0,0 +1,446 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SendpulseSpfInclude is the SPF include SendPulse requires in the sending domain record
+	SendpulseSpfInclude = "mxsmtp.sendpulse.com"
+	// SendpulseDkimSelector is the DKIM selector used by SendPulse
+	SendpulseDkimSelector = "sign"
+
+	defaultOnboardingPollInterval = time.Minute
+)
+
+// DnsRecord describes a DNS record which must be published for a sending domain
+type DnsRecord struct {
+	Type    string
+	Name    string
+	Value   string
+	Purpose string
+}
+
+// RequiredDnsRecords returns DNS records SendPulse requires for a sending domain.
+// The DKIM key is generated per account, so its value must be copied from the SendPulse account settings
+func RequiredDnsRecords(domain string) []*DnsRecord {
+	return requiredDnsRecords(domain, SendpulseDkimSelector)
+}
+
+func requiredDnsRecords(domain string, dkimSelector string) []*DnsRecord {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	return []*DnsRecord{
+		{Type: "TXT", Name: domain, Value: "v=spf1 include:" + SendpulseSpfInclude + " ~all", Purpose: "SPF"},
+		{Type: "TXT", Name: dkimSelector + "._domainkey." + domain, Value: "v=DKIM1; k=rsa; p=<public key from SendPulse settings>", Purpose: "DKIM"},
+		{Type: "TXT", Name: "_dmarc." + domain, Value: "v=DMARC1; p=none", Purpose: "DMARC"},
+	}
+}
+
+// OnboardingState is a state of a sender or a domain onboarding
+type OnboardingState string
+
+const (
+	OnboardingNotStarted OnboardingState = "not_started"
+	OnboardingPending    OnboardingState = "pending"
+	OnboardingReady      OnboardingState = "ready"
+)
+
+// SenderOnboardingState describes onboarding of an email sender
+type SenderOnboardingState struct {
+	Name  string
+	Email string
+	State OnboardingState
+	// Status is the sender status returned by SendPulse
+	Status         string
+	CodeRequested  bool
+	LastCheckedAt  time.Time
+	LastCheckError string
+}
+
+// DomainOnboardingState describes onboarding of an SMTP sending domain
+type DomainOnboardingState struct {
+	Domain string
+	// Email is the address the verification email is sent to
+	Email          string
+	State          OnboardingState
+	DnsRecords     []*DnsRecord
+	LastCheckedAt  time.Time
+	LastCheckError string
+}
+
+// SenderReadiness is a consolidated readiness of a sender email
+type SenderReadiness struct {
+	Email string
+	// CampaignReady is true if the sender is active and can be used in CampaignParams.SenderEmail
+	CampaignReady bool
+	// SmtpReady is true if the domain is verified and the email can be used in SendEmailParams.From
+	SmtpReady bool
+	Issues    []string
+}
+
+// ReadinessReport represents readiness of senders
+type ReadinessReport struct {
+	Senders []*SenderReadiness
+}
+
+// Ready reports whether all senders are ready for campaigns and SMTP
+func (r *ReadinessReport) Ready() bool {
+	for _, sender := range r.Senders {
+		if !sender.CampaignReady || !sender.SmtpReady {
+			return false
+		}
+	}
+	return true
+}
+
+// Sender returns readiness of a sender email or nil
+func (r *ReadinessReport) Sender(email string) *SenderReadiness {
+	for _, sender := range r.Senders {
+		if strings.EqualFold(sender.Email, email) {
+			return sender
+		}
+	}
+	return nil
+}
+
+// SenderOnboarding guides activation of email senders and verification of SMTP domains
+type SenderOnboarding struct {
+	client  *Client
+	lock    sync.Mutex
+	senders map[string]*SenderOnboardingState
+	domains map[string]*DomainOnboardingState
+	// PollInterval is an interval between checks of WaitUntilReady (default: 1 minute)
+	PollInterval time.Duration
+	now          func() time.Time
+}
+
+// NewSenderOnboarding creates SenderOnboarding
+func NewSenderOnboarding(client *Client) *SenderOnboarding {
+	return &SenderOnboarding{
+		client:       client,
+		senders:      make(map[string]*SenderOnboardingState),
+		domains:      make(map[string]*DomainOnboardingState),
+		PollInterval: defaultOnboardingPollInterval,
+		now:          time.Now,
+	}
+}
+
+// StartSender creates a sender if it doesn't exist and requests its activation code unless it's already active
+func (o *SenderOnboarding) StartSender(ctx context.Context, name, email string) (*SenderOnboardingState, error) {
+	sender, err := o.findSender(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if sender == nil {
+		if err := o.client.Emails.Senders.CreateSender(ctx, name, email); err != nil {
+			return nil, err
+		}
+		sender = &Sender{Name: name, Email: email}
+	}
+	active := isActiveSender(sender)
+	if !active {
+		if err := o.client.Emails.Senders.GetSenderActivationCode(ctx, email); err != nil {
+			return nil, err
+		}
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	state := o.sender(email)
+	state.Name = name
+	o.applySender(state, sender)
+	if !active {
+		state.CodeRequested = true
+	}
+	copied := *state
+	return &copied, nil
+}
+
+// ActivateSender activates a sender with the code from the activation email
+func (o *SenderOnboarding) ActivateSender(ctx context.Context, email, code string) (*SenderOnboardingState, error) {
+	if err := o.client.Emails.Senders.ActivateSender(ctx, email, code); err != nil {
+		return nil, err
+	}
+	sender, err := o.findSender(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	state := o.sender(email)
+	if sender != nil {
+		o.applySender(state, sender)
+	}
+	copied := *state
+	return &copied, nil
+}
+
+// StartDomain adds an SMTP sending domain by an email of the domain unless it's already verified.
+// SendPulse sends a verification email to the address. The returned state lists DNS records which must be published
+func (o *SenderOnboarding) StartDomain(ctx context.Context, email string) (*DomainOnboardingState, error) {
+	domain := emailDomain(email)
+	if domain == "" {
+		return nil, fmt.Errorf("invalid email %q", email)
+	}
+	allowed, err := o.client.SMTP.GetAllowedDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ready := containsFold(allowed, domain)
+	if !ready {
+		if err := o.client.SMTP.AddDomain(ctx, email); err != nil {
+			return nil, err
+		}
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	state := o.domain(domain)
+	state.Email = email
+	state.LastCheckedAt = o.now()
+	if ready {
+		state.State = OnboardingReady
+	} else {
+		state.State = OnboardingPending
+	}
+	copied := *state
+	return &copied, nil
+}
+
+// VerifyDomain sends the verification email of a pending domain again
+func (o *SenderOnboarding) VerifyDomain(ctx context.Context, domain string) (*DomainOnboardingState, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	o.lock.Lock()
+	state, ok := o.domains[domain]
+	var email string
+	if ok {
+		email = state.Email
+	}
+	o.lock.Unlock()
+	if !ok || email == "" {
+		return nil, fmt.Errorf("domain %s is not started", domain)
+	}
+
+	err := o.client.SMTP.VerifyDomain(ctx, email)
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	state.LastCheckError = ""
+	if err != nil {
+		state.LastCheckError = err.Error()
+	}
+	copied := *state
+	return &copied, err
+}
+
+// Refresh re-checks all tracked senders and domains. It doesn't send verification emails
+func (o *SenderOnboarding) Refresh(ctx context.Context) error {
+	senders, err := o.client.Emails.Senders.GetSenders(ctx)
+	if err != nil {
+		return err
+	}
+	allowed, err := o.client.SMTP.GetAllowedDomains(ctx)
+	if err != nil {
+		return err
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	for _, state := range o.senders {
+		state.LastCheckedAt = o.now()
+		state.LastCheckError = ""
+		sender := findSender(senders, state.Email)
+		if sender == nil {
+			state.State = OnboardingNotStarted
+			state.Status = ""
+			continue
+		}
+		o.applySender(state, sender)
+	}
+
+	for _, state := range o.domains {
+		state.LastCheckedAt = o.now()
+		if containsFold(allowed, state.Domain) {
+			state.State = OnboardingReady
+		} else if state.State == OnboardingReady {
+			state.State = OnboardingPending
+		}
+	}
+	return nil
+}
+
+// WaitUntilReady re-checks senders and domains with PollInterval until all of them are ready.
+// Use the context to limit the waiting time
+func (o *SenderOnboarding) WaitUntilReady(ctx context.Context) error {
+	interval := o.PollInterval
+	if interval <= 0 {
+		interval = defaultOnboardingPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := o.Refresh(ctx); err != nil {
+			return err
+		}
+		if o.allReady() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Senders returns states of tracked senders ordered by email
+func (o *SenderOnboarding) Senders() []*SenderOnboardingState {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	states := make([]*SenderOnboardingState, 0, len(o.senders))
+	for _, state := range o.senders {
+		copied := *state
+		states = append(states, &copied)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Email < states[j].Email
+	})
+	return states
+}
+
+// Domains returns states of tracked domains ordered by domain
+func (o *SenderOnboarding) Domains() []*DomainOnboardingState {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	states := make([]*DomainOnboardingState, 0, len(o.domains))
+	for _, state := range o.domains {
+		copied := *state
+		states = append(states, &copied)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Domain < states[j].Domain
+	})
+	return states
+}
+
+// Readiness checks whether sender emails, e.g. CampaignParams.SenderEmail and SendEmailParams.From.Email,
+// can be used for campaigns and SMTP
+func (o *SenderOnboarding) Readiness(ctx context.Context, emails ...string) (*ReadinessReport, error) {
+	senders, err := o.client.Emails.Senders.GetSenders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := o.client.SMTP.GetAllowedDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReadinessReport{}
+	seen := make(map[string]bool)
+	for _, email := range emails {
+		key := normalizeEmail(email)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		readiness := &SenderReadiness{Email: email}
+		switch sender := findSender(senders, email); {
+		case sender == nil:
+			readiness.Issues = append(readiness.Issues, "sender is not added to SendPulse")
+		case !isActiveSender(sender):
+			readiness.Issues = append(readiness.Issues, fmt.Sprintf("sender is not active: %s", sender.Status))
+		default:
+			readiness.CampaignReady = true
+		}
+
+		if domain := emailDomain(email); containsFold(allowed, domain) {
+			readiness.SmtpReady = true
+		} else {
+			readiness.Issues = append(readiness.Issues, fmt.Sprintf("SMTP domain %s is not verified", domain))
+		}
+		report.Senders = append(report.Senders, readiness)
+	}
+	return report, nil
+}
+
+func (o *SenderOnboarding) allReady() bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	for _, state := range o.senders {
+		if state.State != OnboardingReady {
+			return false
+		}
+	}
+	for _, state := range o.domains {
+		if state.State != OnboardingReady {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *SenderOnboarding) findSender(ctx context.Context, email string) (*Sender, error) {
+	senders, err := o.client.Emails.Senders.GetSenders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findSender(senders, email), nil
+}
+
+func (o *SenderOnboarding) applySender(state *SenderOnboardingState, sender *Sender) {
+	state.Status = sender.Status
+	state.LastCheckedAt = o.now()
+	if state.Name == "" {
+		state.Name = sender.Name
+	}
+	if isActiveSender(sender) {
+		state.State = OnboardingReady
+	} else {
+		state.State = OnboardingPending
+	}
+}
+
+// sender returns a tracked sender state creating it if needed. The lock must be held
+func (o *SenderOnboarding) sender(email string) *SenderOnboardingState {
+	key := normalizeEmail(email)
+	if _, ok := o.senders[key]; !ok {
+		o.senders[key] = &SenderOnboardingState{Email: email, State: OnboardingNotStarted}
+	}
+	return o.senders[key]
+}
+
+// domain returns a tracked domain state creating it if needed. The lock must be held
+func (o *SenderOnboarding) domain(domain string) *DomainOnboardingState {
+	if _, ok := o.domains[domain]; !ok {
+		o.domains[domain] = &DomainOnboardingState{
+			Domain:     domain,
+			State:      OnboardingNotStarted,
+			DnsRecords: RequiredDnsRecords(domain),
+		}
+	}
+	return o.domains[domain]
+}
+
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestSenderOnboarding_Flow() {
+	activated := false
+	domainChecks := 0
+	suite.mux.HandleFunc("/senders", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprintf(w, `{"result": true}`)
+		default:
+			status := "Requested activation"
+			if activated {
+				status = "Active"
+			}
+			fmt.Fprintf(w, `[{"name": "Alex", "email": "alex@test.com", "status": %q}]`, status)
+		}
+	})
+	suite.mux.HandleFunc("/senders/alex@test.com/code", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			activated = true
+		}
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+	suite.mux.HandleFunc("/smtp/domains", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		// the domain is confirmed from the verification email after a few checks
+		domainChecks++
+		if domainChecks > 4 {
+			fmt.Fprintf(w, `["test.com"]`)
+			return
+		}
+		fmt.Fprintf(w, `[]`)
+	})
+	verifications := 0
+	suite.mux.HandleFunc("/domains/alex@test.com", func(w http.ResponseWriter, r *http.Request) {
+		verifications++
+		fmt.Fprintf(w, `{"result": true}`)
+	})
+
+	onboarding := NewSenderOnboarding(suite.client)
+	sender, err := onboarding.StartSender(context.Background(), "Alex", "alex@test.com")
+	suite.NoError(err)
+	suite.Equal(OnboardingPending, sender.State)
+	suite.True(sender.CodeRequested)
+
+	domain, err := onboarding.StartDomain(context.Background(), "alex@test.com")
+	suite.NoError(err)
+	suite.Equal("test.com", domain.Domain)
+	suite.Equal(OnboardingPending, domain.State)
+	suite.Equal(3, len(domain.DnsRecords))
+	suite.Equal("_dmarc.test.com", domain.DnsRecords[2].Name)
+
+	report, err := onboarding.Readiness(context.Background(), "alex@test.com", "ALEX@test.com", "bob@other.com")
+	suite.NoError(err)
+	suite.False(report.Ready())
+	suite.Equal(2, len(report.Senders))
+	suite.False(report.Sender("alex@test.com").CampaignReady)
+	suite.Equal(2, len(report.Sender("bob@other.com").Issues))
+
+	sender, err = onboarding.ActivateSender(context.Background(), "alex@test.com", "12345")
+	suite.NoError(err)
+	suite.Equal(OnboardingReady, sender.State)
+
+	_, err = onboarding.VerifyDomain(context.Background(), "other.com")
+	suite.Error(err)
+	domain, err = onboarding.VerifyDomain(context.Background(), "test.com")
+	suite.NoError(err)
+	suite.Equal(OnboardingPending, domain.State)
+	suite.Equal(1, verifications)
+
+	onboarding.PollInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	suite.NoError(onboarding.WaitUntilReady(ctx))
+	suite.Equal(OnboardingReady, onboarding.Domains()[0].State)
+	suite.Equal(1, verifications)
+
+	report, err = onboarding.Readiness(context.Background(), "alex@test.com")
+	suite.NoError(err)
+	suite.True(report.Ready())
+}
@@ -0,0 +1,188 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"strings"
+	"unicode/utf16"
+)
+
+// SmsEncoding is an encoding used to deliver an SMS body
+type SmsEncoding string
+
+const (
+	SmsEncodingGSM7 SmsEncoding = "GSM-7"
+	SmsEncodingUCS2 SmsEncoding = "UCS-2"
+)
+
+const (
+	gsm7SingleSegmentLength = 160
+	gsm7MultiSegmentLength  = 153
+	ucs2SingleSegmentLength = 70
+	ucs2MultiSegmentLength  = 67
+	gsm7BasicCharset        = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	gsm7ExtensionCharset    = "^{}\\[~]|€\f"
+)
+
+var (
+	gsm7Basic     = runeSet(gsm7BasicCharset)
+	gsm7Extension = runeSet(gsm7ExtensionCharset)
+)
+
+// SmsBodyAnalysis describes how an SMS body is encoded and split into segments
+type SmsBodyAnalysis struct {
+	Body     string
+	Encoding SmsEncoding
+	// Characters is the number of characters in the body
+	Characters int
+	// Units is the encoded length: GSM-7 septets or UCS-2 code units
+	Units int
+	// Segments is the number of SMS parts the body takes
+	Segments int
+	// SegmentLength is the max number of units per segment for the current body
+	SegmentLength int
+	// UnsupportedChars lists unique characters that are missing from GSM-7 and force UCS-2 encoding
+	UnsupportedChars []string
+}
+
+// AnalyzeSmsBody detects the encoding of an SMS body and counts its segments
+func AnalyzeSmsBody(body string) *SmsBodyAnalysis {
+	analysis := &SmsBodyAnalysis{
+		Body:     body,
+		Encoding: SmsEncodingGSM7,
+	}
+
+	seen := make(map[rune]bool)
+	septets := 0
+	for _, r := range body {
+		analysis.Characters++
+		switch {
+		case gsm7Basic[r]:
+			septets++
+		case gsm7Extension[r]:
+			septets += 2
+		default:
+			if !seen[r] {
+				seen[r] = true
+				analysis.UnsupportedChars = append(analysis.UnsupportedChars, string(r))
+			}
+		}
+	}
+
+	if len(analysis.UnsupportedChars) == 0 {
+		analysis.Units = septets
+		analysis.SegmentLength = gsm7SingleSegmentLength
+		if septets > gsm7SingleSegmentLength {
+			analysis.SegmentLength = gsm7MultiSegmentLength
+		}
+	} else {
+		analysis.Encoding = SmsEncodingUCS2
+		analysis.Units = len(utf16.Encode([]rune(body)))
+		analysis.SegmentLength = ucs2SingleSegmentLength
+		if analysis.Units > ucs2SingleSegmentLength {
+			analysis.SegmentLength = ucs2MultiSegmentLength
+		}
+	}
+
+	if analysis.Units > 0 {
+		analysis.Segments = (analysis.Units + analysis.SegmentLength - 1) / analysis.SegmentLength
+	}
+	return analysis
+}
+
+var smsTransliterationTable = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ё': "E", 'Ж': "Zh", 'З': "Z", 'И': "I",
+	'Й': "Y", 'К': "K", 'Л': "L", 'М': "M", 'Н': "N", 'О': "O", 'П': "P", 'Р': "R", 'С': "S", 'Т': "T",
+	'У': "U", 'Ф': "F", 'Х': "Kh", 'Ц': "Ts", 'Ч': "Ch", 'Ш': "Sh", 'Щ': "Shch", 'Ъ': "", 'Ы': "Y", 'Ь': "",
+	'Э': "E", 'Ю': "Yu", 'Я': "Ya", 'І': "I", 'Ї': "Yi", 'Є': "Ye", 'Ґ': "G",
+	'«': "\"", '»': "\"", '“': "\"", '”': "\"", '„': "\"", '‘': "'", '’': "'", '–': "-", '—': "-",
+	'…': "...", '№': "N", ' ': " ",
+}
+
+// TransliterateSmsBody replaces Cyrillic letters and typographic punctuation with GSM-7 compatible characters.
+// Characters without a known replacement are kept as is
+func TransliterateSmsBody(body string) string {
+	var sb strings.Builder
+	for _, r := range body {
+		if replacement, ok := smsTransliterationTable[r]; ok {
+			sb.WriteString(replacement)
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// SmsCostPreview represents an SMS body analysis combined with the campaign cost.
+// RecipientsQty, PricePerPhone and PricePerSegment are set only for campaigns by phones,
+// because the API doesn't return the number of phones in an address book
+type SmsCostPreview struct {
+	Analysis        *SmsBodyAnalysis
+	Cost            *SmsCampaignCampaignCost
+	RecipientsQty   int
+	PricePerPhone   float32
+	PricePerSegment float32
+}
+
+// GetCampaignCostPreview analyzes the campaign body and returns its cost split per recipient.
+// Phones are normalized and de-duplicated before pricing. If transliterate is set, the transliterated body is analyzed and priced
+func (service *SmsService) GetCampaignCostPreview(ctx context.Context, params SmsCampaignCostParams, transliterate bool) (*SmsCostPreview, error) {
+	if transliterate {
+		params.Body = TransliterateSmsBody(params.Body)
+	}
+	phones, err := service.client.preparePhones(params.Phones)
+	if err != nil {
+		return nil, err
+	}
+	if params.Phones, err = uniquePhones(phones); err != nil {
+		return nil, err
+	}
+
+	cost, err := service.GetCampaignCost(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &SmsCostPreview{
+		Analysis: AnalyzeSmsBody(params.Body),
+		Cost:     cost,
+	}
+	if params.AddressBookID == 0 {
+		preview.RecipientsQty = len(params.Phones)
+	}
+	if cost != nil && preview.RecipientsQty > 0 {
+		preview.PricePerPhone = cost.Price / float32(preview.RecipientsQty)
+		if preview.Analysis.Segments > 0 {
+			preview.PricePerSegment = preview.PricePerPhone / float32(preview.Analysis.Segments)
+		}
+	}
+	return preview, nil
+}
+
+// uniquePhones removes formatting characters from phones and drops duplicates keeping the order
+func uniquePhones(phones []string) ([]string, error) {
+	seen := make(map[string]bool, len(phones))
+	result := make([]string, 0, len(phones))
+	for _, phone := range phones {
+		digits, _, err := cleanPhone(phone)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[digits] {
+			seen[digits] = true
+			result = append(result, digits)
+		}
+	}
+	return result, nil
+}
+
+func runeSet(chars string) map[rune]bool {
+	set := make(map[rune]bool)
+	for _, r := range chars {
+		set[r] = true
+	}
+	return set
+}
@@ -0,0 +1,78 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (suite *SendpulseTestSuite) TestSmsAnalyzer_AnalyzeGSM7() {
+	analysis := AnalyzeSmsBody("Hello [world]")
+	suite.Equal(SmsEncodingGSM7, analysis.Encoding)
+	suite.Equal(13, analysis.Characters)
+	suite.Equal(15, analysis.Units)
+	suite.Equal(1, analysis.Segments)
+	suite.Empty(analysis.UnsupportedChars)
+
+	analysis = AnalyzeSmsBody(strings.Repeat("a", 161))
+	suite.Equal(2, analysis.Segments)
+	suite.Equal(153, analysis.SegmentLength)
+}
+
+func (suite *SendpulseTestSuite) TestSmsAnalyzer_AnalyzeUCS2() {
+	analysis := AnalyzeSmsBody("Привет, мир")
+	suite.Equal(SmsEncodingUCS2, analysis.Encoding)
+	suite.Equal(11, analysis.Units)
+	suite.Equal(1, analysis.Segments)
+	suite.Contains(analysis.UnsupportedChars, "П")
+
+	analysis = AnalyzeSmsBody(strings.Repeat("я", 71))
+	suite.Equal(2, analysis.Segments)
+
+	analysis = AnalyzeSmsBody("")
+	suite.Equal(0, analysis.Segments)
+}
+
+func (suite *SendpulseTestSuite) TestSmsAnalyzer_Transliterate() {
+	body := TransliterateSmsBody("Привет — «мир»")
+	suite.Equal("Privet - \"mir\"", body)
+	suite.Equal(SmsEncodingGSM7, AnalyzeSmsBody(body).Encoding)
+}
+
+func (suite *SendpulseTestSuite) TestSmsService_GetCampaignCostPreview() {
+	suite.mux.HandleFunc("/sms/campaigns/cost", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodGet, r.Method)
+		suite.Equal("Privet", r.URL.Query().Get("body"))
+		if r.URL.Query().Get("addressBookId") == "" {
+			suite.Equal("[79217451232,79217451233]", r.URL.Query().Get("phones"))
+		}
+		fmt.Fprintf(w, `{
+		   "result": true,
+		   "data": {
+			 "price": 4,
+			 "currency": "UAH"
+		   }
+		}`)
+	})
+
+	preview, err := suite.client.SMS.GetCampaignCostPreview(context.Background(), SmsCampaignCostParams{
+		Phones: []string{"79217451232", "+7 921 745-12-33", "79217451232"},
+		Body:   "Привет",
+		Sender: "Alex",
+	}, true)
+	suite.NoError(err)
+	suite.Equal(SmsEncodingGSM7, preview.Analysis.Encoding)
+	suite.Equal(2, preview.RecipientsQty)
+	suite.Equal(float32(2), preview.PricePerPhone)
+	suite.Equal(float32(2), preview.PricePerSegment)
+
+	preview, err = suite.client.SMS.GetCampaignCostPreview(context.Background(), SmsCampaignCostParams{
+		AddressBookID: 1,
+		Body:          "Privet",
+	}, false)
+	suite.NoError(err)
+	suite.Equal(float32(4), preview.Cost.Price)
+	suite.Zero(preview.RecipientsQty)
+	suite.Zero(preview.PricePerPhone)
+}
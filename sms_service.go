@@ -33,6 +33,11 @@ type AddPhonesCounters struct {
 
 func (service *SmsService) AddPhones(ctx context.Context, mailingListID int, phones []string) (*AddPhonesCounters, error) {
 	path := "/sms/numbers"
+	phones, err := service.client.preparePhones(phones)
+	if err != nil {
+		return nil, err
+	}
+
 	type paramsFormat struct {
 		AddressBookID int      `json:"addressBookId"`
 		Phones        []string `json:"phones"`
@@ -44,7 +49,7 @@ func (service *SmsService) AddPhones(ctx context.Context, mailingListID int, pho
 		Result   bool               `json:"result"`
 		Counters *AddPhonesCounters `json:"counters"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, data, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, data, &respData, true)
 	return respData.Counters, err
 }
 
@@ -62,7 +67,11 @@ func (service *SmsService) AddPhonesWithVariables(ctx context.Context, mailingLi
 
 	ph := make(map[string][][]SmsVariable)
 	for _, item := range phones {
-		ph[item.Phone] = append(ph[item.Phone], item.Variables)
+		phone, err := service.client.preparePhone(item.Phone)
+		if err != nil {
+			return nil, err
+		}
+		ph[phone] = append(ph[phone], item.Variables)
 	}
 
 	data := paramsFormat{
@@ -79,6 +88,10 @@ func (service *SmsService) AddPhonesWithVariables(ctx context.Context, mailingLi
 }
 
 func (service *SmsService) UpdateVariablesSingle(ctx context.Context, addressBookID int, phone string, variables []SmsVariable) error {
+	phone, err := service.client.preparePhone(phone)
+	if err != nil {
+		return err
+	}
 	path := fmt.Sprintf("/addressbooks/%d/phones/variable", addressBookID)
 	type paramsFormat struct {
 		Phone     string        `json:"phone"`
@@ -90,11 +103,15 @@ func (service *SmsService) UpdateVariablesSingle(ctx context.Context, addressBoo
 	var respData struct {
 		Result bool `json:"result"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, data, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, data, &respData, true)
 	return err
 }
 
 func (service *SmsService) UpdateVariablesMultiple(ctx context.Context, addressBookID int, phones []string, variables []SmsVariable) error {
+	phones, err := service.client.preparePhones(phones)
+	if err != nil {
+		return err
+	}
 	path := "/sms/numbers"
 	type paramsFormat struct {
 		AddressBookID int           `json:"addressBookId"`
@@ -111,11 +128,15 @@ func (service *SmsService) UpdateVariablesMultiple(ctx context.Context, addressB
 	var respData struct {
 		Result bool `json:"result"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPut, path, data, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPut, path, data, &respData, true)
 	return err
 }
 
 func (service *SmsService) DeletePhones(ctx context.Context, addressBookID int, phones []string) error {
+	phones, err := service.client.preparePhones(phones)
+	if err != nil {
+		return err
+	}
 	path := "/sms/numbers"
 	type paramsFormat struct {
 		AddressBookID int      `json:"addressBookId"`
@@ -130,27 +151,35 @@ func (service *SmsService) DeletePhones(ctx context.Context, addressBookID int,
 	var respData struct {
 		Result bool `json:"result"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodDelete, path, data, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodDelete, path, data, &respData, true)
 	return err
 }
 
 type PhoneInfo struct {
-	Status    int                    `json:"status"`
+	Status    SubscriberStatus       `json:"status"`
 	Variables map[string]interface{} `json:"variables"`
 	Added     DateTimeType           `json:"added"`
 }
 
 func (service *SmsService) GetPhoneInfo(ctx context.Context, addressBookID int, phone string) (*PhoneInfo, error) {
+	phone, err := service.client.preparePhone(phone)
+	if err != nil {
+		return nil, err
+	}
 	path := fmt.Sprintf("/sms/numbers/info/%d/%s", addressBookID, phone)
 	var respData struct {
 		Result bool       `json:"result"`
 		Data   *PhoneInfo `json:"data"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodGet, path, nil, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodGet, path, nil, &respData, true)
 	return respData.Data, err
 }
 
 func (service *SmsService) AddToBlacklist(ctx context.Context, phones []string, description string) error {
+	phones, err := service.client.preparePhones(phones)
+	if err != nil {
+		return err
+	}
 	path := "/sms/black_list"
 
 	type paramsFormat struct {
@@ -166,11 +195,15 @@ func (service *SmsService) AddToBlacklist(ctx context.Context, phones []string,
 	var respData struct {
 		Result bool `json:"result"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, data, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, data, &respData, true)
 	return err
 }
 
 func (service *SmsService) RemoveFromBlacklist(ctx context.Context, phones []string) error {
+	phones, err := service.client.preparePhones(phones)
+	if err != nil {
+		return err
+	}
 	path := "/sms/black_list"
 
 	type paramsFormat struct {
@@ -184,7 +217,7 @@ func (service *SmsService) RemoveFromBlacklist(ctx context.Context, phones []str
 	var respData struct {
 		Result bool `json:"result"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodDelete, path, data, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodDelete, path, data, &respData, true)
 	return err
 }
 
@@ -195,6 +228,10 @@ type BlacklistPhone struct {
 }
 
 func (service *SmsService) GetBlacklistedPhones(ctx context.Context, phones []string) ([]*BlacklistPhone, error) {
+	phones, err := service.client.preparePhones(phones)
+	if err != nil {
+		return nil, err
+	}
 	path := "/sms/black_list/by_numbers"
 	urlParams := url.Values{}
 	urlParams.Add("phones", "["+strings.Join(phones, ",")+"]")
@@ -210,7 +247,7 @@ func (service *SmsService) GetBlacklistedPhones(ctx context.Context, phones []st
 		Data   []*BlacklistPhoneInternal `json:"data"`
 	}
 
-	_, err := service.client.newRequest(ctx, http.MethodGet, path, nil, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodGet, path, nil, &respData, true)
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +261,33 @@ func (service *SmsService) GetBlacklistedPhones(ctx context.Context, phones []st
 	return data, nil
 }
 
+// GetBlacklist returns all phones in the SMS blacklist
+func (service *SmsService) GetBlacklist(ctx context.Context) ([]*BlacklistPhone, error) {
+	path := "/sms/black_list"
+
+	type BlacklistPhoneInternal struct {
+		BlacklistPhone
+		Phone int `json:"phone"`
+	}
+
+	var respData struct {
+		Result bool                      `json:"result"`
+		Data   []*BlacklistPhoneInternal `json:"data"`
+	}
+
+	_, err := service.client.newRequest(ctx, http.MethodGet, path, nil, &respData, true)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*BlacklistPhone, len(respData.Data))
+	for i, item := range respData.Data {
+		item.BlacklistPhone.Phone = strconv.Itoa(item.Phone)
+		data[i] = &item.BlacklistPhone
+	}
+	return data, nil
+}
+
 type CreateSmsCampaignByAddressBookParams struct {
 	Sender        string            `json:"sender"`
 	MailingListID int               `json:"addressBookId"`
@@ -234,8 +298,18 @@ type CreateSmsCampaignByAddressBookParams struct {
 	Emulate       int               `json:"emulate"`
 }
 
+// MarshalJSON omits Date if it isn't set
+func (p CreateSmsCampaignByAddressBookParams) MarshalJSON() ([]byte, error) {
+	type params CreateSmsCampaignByAddressBookParams
+	return json.Marshal(struct {
+		params
+		Date *DateTimeType `json:"date,omitempty"`
+	}{params(p), p.Date.ptr()})
+}
+
 func (service *SmsService) CreateCampaignByMailingList(ctx context.Context, params CreateSmsCampaignByAddressBookParams) (int, error) {
 	path := "/sms/campaigns"
+	params.Date = service.client.accountDate(params.Date)
 
 	var respData struct {
 		Result     bool `json:"result"`
@@ -255,14 +329,29 @@ type CreateSmsCampaignByPhonesParams struct {
 	Emulate       int               `json:"emulate"`
 }
 
+// MarshalJSON omits Date if it isn't set
+func (p CreateSmsCampaignByPhonesParams) MarshalJSON() ([]byte, error) {
+	type params CreateSmsCampaignByPhonesParams
+	return json.Marshal(struct {
+		params
+		Date *DateTimeType `json:"date,omitempty"`
+	}{params(p), p.Date.ptr()})
+}
+
 func (service *SmsService) CreateCampaignByPhones(ctx context.Context, params CreateSmsCampaignByPhonesParams) (int, error) {
 	path := "/sms/send"
+	phones, err := service.client.preparePhones(params.Phones)
+	if err != nil {
+		return 0, err
+	}
+	params.Phones = phones
+	params.Date = service.client.accountDate(params.Date)
 
 	var respData struct {
 		Result     bool `json:"result"`
 		CampaignID int  `json:"campaign_id"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, params, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, params, &respData, true)
 	return respData.CampaignID, err
 }
 
@@ -375,12 +464,12 @@ func (service *SmsService) GetCampaignCost(ctx context.Context, params SmsCampai
 }
 
 type SmsSender struct {
-	ID            int    `json:"id"`
-	Sender        string `json:"sender"`
-	Country       string `json:"country"`
-	CountryCode   string `json:"country_code"`
-	Status        int    `json:"status"`
-	StatusExplain string `json:"status_explain"`
+	ID            int             `json:"id"`
+	Sender        string          `json:"sender"`
+	Country       string          `json:"country"`
+	CountryCode   string          `json:"country_code"`
+	Status        SmsSenderStatus `json:"status"`
+	StatusExplain string          `json:"status_explain"`
 }
 
 func (service *SmsService) GetSenders(ctx context.Context) ([]*SmsSender, error) {
@@ -4,7 +4,12 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/mail"
+	"net/textproto"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -23,19 +28,26 @@ type EmailTemplate struct {
 }
 
 type User struct {
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	Name      string                 `json:"name"`
+	Email     string                 `json:"email"`
+	Variables map[string]interface{} `json:"variables,omitempty"` // Template variables of a specific recipient
 }
 
 type SendEmailParams struct {
-	Html          string            `json:"html,omitempty"`
-	Text          string            `json:"text,omitempty"`
-	Template      *EmailTemplate    `json:"template"`
-	AutoPlainText bool              `json:"auto_plain_text"`
-	Subject       string            `json:"subject"`
-	From          User              `json:"from"`
-	To            []User            `json:"to"`
-	Attachments   map[string]string `json:"attachments"`
+	Html              string            `json:"html,omitempty"`
+	Text              string            `json:"text,omitempty"`
+	Template          *EmailTemplate    `json:"template"`
+	AutoPlainText     bool              `json:"auto_plain_text"`
+	Subject           string            `json:"subject"`
+	From              User              `json:"from"`
+	To                []User            `json:"to"`
+	Cc                []User            `json:"cc,omitempty"`
+	Bcc               []User            `json:"bcc,omitempty"`
+	ReplyTo           *User             `json:"-"`
+	Headers           map[string]string `json:"headers,omitempty"`
+	Attachments       map[string]string `json:"attachments"`
+	AttachmentsBinary map[string]string `json:"attachments_binary,omitempty"`
+	AttachmentsURL    map[string]string `json:"-"` // Files by name which are downloaded and sent as binary attachments
 }
 
 func (service *SmtpService) SendMessage(ctx context.Context, params SendEmailParams) (string, error) {
@@ -45,6 +57,23 @@ func (service *SmtpService) SendMessage(ctx context.Context, params SendEmailPar
 		Email SendEmailParams `json:"email"`
 	}
 
+	headers, err := prepareSmtpHeaders(params)
+	if err != nil {
+		return "", err
+	}
+	params.Headers = headers
+
+	if len(params.AttachmentsURL) != 0 {
+		attachments, err := service.downloadAttachments(ctx, params.AttachmentsURL)
+		if err != nil {
+			return "", err
+		}
+		for name, content := range params.AttachmentsBinary {
+			attachments[name] = content
+		}
+		params.AttachmentsBinary = attachments
+	}
+
 	if params.Html != "" {
 		html := b64.StdEncoding.EncodeToString([]byte(params.Html))
 		params.Html = html
@@ -56,10 +85,63 @@ func (service *SmtpService) SendMessage(ctx context.Context, params SendEmailPar
 		Result bool   `json:"result"`
 		ID     string `json:"id"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, data, &response, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, data, &response, true)
 	return response.ID, err
 }
 
+// prepareSmtpHeaders validates custom headers and adds the Reply-To header
+func prepareSmtpHeaders(params SendEmailParams) (map[string]string, error) {
+	if len(params.Headers) == 0 && params.ReplyTo == nil {
+		return nil, nil
+	}
+
+	headers := make(map[string]string, len(params.Headers)+1)
+	for name, value := range params.Headers {
+		if name == "" || strings.ContainsAny(name, "\r\n: ") || strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("invalid header %q", name)
+		}
+		headers[textproto.CanonicalMIMEHeaderKey(name)] = value
+	}
+
+	if params.ReplyTo != nil {
+		address := mail.Address{Name: params.ReplyTo.Name, Address: params.ReplyTo.Email}
+		headers["Reply-To"] = address.String()
+	}
+	return headers, nil
+}
+
+// downloadAttachments downloads files and returns their base64 encoded contents by file name.
+// The total size of the files is limited by DefaultMaxEmailSize
+func (service *SmtpService) downloadAttachments(ctx context.Context, urls map[string]string) (map[string]string, error) {
+	attachments := make(map[string]string, len(urls))
+	remaining := int64(DefaultMaxEmailSize)
+	for name, fileUrl := range urls {
+		req, err := http.NewRequest(http.MethodGet, fileUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := service.client.client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to download attachment %q: http code %d", name, resp.StatusCode)
+		}
+		content, err := ioutil.ReadAll(io.LimitReader(resp.Body, remaining+1))
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		remaining -= int64(len(content))
+		if remaining < 0 {
+			return nil, fmt.Errorf("failed to download attachment %q: attachments exceed %d bytes", name, DefaultMaxEmailSize)
+		}
+		attachments[name] = b64.StdEncoding.EncodeToString(content)
+	}
+	return attachments, nil
+}
+
 type SmtpMessage struct {
 	ID                    string       `json:"id"`
 	Sender                string       `json:"sender"`
@@ -113,13 +195,13 @@ func (service *SmtpService) GetMessages(ctx context.Context, params SmtpListPara
 		urlParts = append(urlParts, fmt.Sprintf("from=%s", params.From.Format("2006-01-02")))
 	}
 	if !params.To.IsZero() {
-		urlParts = append(urlParts, fmt.Sprintf("to=%s", params.From.Format("2006-01-02")))
+		urlParts = append(urlParts, fmt.Sprintf("to=%s", params.To.Format("2006-01-02")))
 	}
 	if params.Sender != "" {
-		urlParts = append(urlParts, fmt.Sprintf("sender=%s", params.Sender))
+		urlParts = append(urlParts, fmt.Sprintf("sender=%s", url.QueryEscape(params.Sender)))
 	}
 	if params.Recipient != "" {
-		urlParts = append(urlParts, fmt.Sprintf("recipient=%s", params.Recipient))
+		urlParts = append(urlParts, fmt.Sprintf("recipient=%s", url.QueryEscape(params.Recipient)))
 	}
 
 	if len(urlParts) != 0 {
@@ -250,6 +332,24 @@ func (service *SmtpService) GetUnsubscribedEmails(ctx context.Context, params Un
 	return respData, err
 }
 
+// GetAllUnsubscribedEmails returns all unsubscribed emails reading them page by page
+func (service *SmtpService) GetAllUnsubscribedEmails(ctx context.Context, pageSize int) ([]Unsubscribed, error) {
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	var result []Unsubscribed
+	for offset := 0; ; offset += pageSize {
+		page, err := service.GetUnsubscribedEmails(ctx, UnsubscribedListParams{Limit: pageSize, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+		if len(page) < pageSize {
+			return result, nil
+		}
+	}
+}
+
 func (service *SmtpService) GetSendersIPs(ctx context.Context) ([]string, error) {
 	path := "/smtp/ips"
 
@@ -2,6 +2,7 @@ package sendpulse_sdk_go
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/bxcodec/faker/v3"
 	"net/http"
@@ -327,3 +328,74 @@ func (suite *SendpulseTestSuite) TestSmtpService_VerifyDomain() {
 	err := suite.client.SMTP.VerifyDomain(context.Background(), email)
 	suite.NoError(err)
 }
+
+func (suite *SendpulseTestSuite) TestSmtpService_SendWithOptions() {
+	suite.mux.HandleFunc("/files/terms.pdf", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%%PDF-1.4")
+	})
+	suite.mux.HandleFunc("/smtp/emails", func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		var body struct {
+			Email struct {
+				Cc                []User            `json:"cc"`
+				Bcc               []User            `json:"bcc"`
+				To                []User            `json:"to"`
+				Headers           map[string]string `json:"headers"`
+				AttachmentsBinary map[string]string `json:"attachments_binary"`
+			} `json:"email"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		suite.Equal("cc@test.com", body.Email.Cc[0].Email)
+		suite.Equal("bcc@test.com", body.Email.Bcc[0].Email)
+		suite.Equal("Bob", body.Email.To[0].Variables["name"])
+		suite.Equal("corr-1", body.Email.Headers["X-Correlation-Id"])
+		suite.Equal(`"Support" <support@test.com>`, body.Email.Headers["Reply-To"])
+		suite.Equal("JVBERi0xLjQ=", body.Email.AttachmentsBinary["terms.pdf"])
+		fmt.Fprintf(w, `{"result": true, "id": "pzkic9-0afezp-fc"}`)
+	})
+
+	id, err := suite.client.SMTP.SendMessage(context.Background(), SendEmailParams{
+		Template: &EmailTemplate{ID: "1"},
+		Subject:  "Notification",
+		From:     User{Name: "Alex", Email: "alex@test.com"},
+		To:       []User{{Name: "Bob", Email: "bob@test.com", Variables: map[string]interface{}{"name": "Bob"}}},
+		Cc:       []User{{Email: "cc@test.com"}},
+		Bcc:      []User{{Email: "bcc@test.com"}},
+		ReplyTo:  &User{Name: "Support", Email: "support@test.com"},
+		Headers: map[string]string{
+			"x-correlation-id": "corr-1",
+			"List-Unsubscribe": "<mailto:unsubscribe@test.com>",
+		},
+		AttachmentsURL: map[string]string{"terms.pdf": "https://example.com/files/terms.pdf"},
+	})
+	suite.NoError(err)
+	suite.Equal("pzkic9-0afezp-fc", id)
+}
+
+func (suite *SendpulseTestSuite) TestSmtpService_SendInvalidHeader() {
+	_, err := suite.client.SMTP.SendMessage(context.Background(), SendEmailParams{
+		Headers: map[string]string{"X-Injected": "value\r\nBcc: evil@test.com"},
+	})
+	suite.Error(err)
+}
+
+func (suite *SendpulseTestSuite) TestSmtpService_SendAttachmentURLErrors() {
+	suite.mux.HandleFunc("/files/missing.pdf", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	suite.mux.HandleFunc("/files/huge.bin", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(make([]byte, DefaultMaxEmailSize+1))
+	})
+
+	_, err := suite.client.SMTP.SendMessage(context.Background(), SendEmailParams{
+		AttachmentsURL: map[string]string{"missing.pdf": "https://example.com/files/missing.pdf"},
+	})
+	suite.Error(err)
+	suite.Contains(err.Error(), "http code 404")
+
+	_, err = suite.client.SMTP.SendMessage(context.Background(), SendEmailParams{
+		AttachmentsURL: map[string]string{"huge.bin": "https://example.com/files/huge.bin"},
+	})
+	suite.Error(err)
+	suite.Contains(err.Error(), "exceed")
+}
@@ -0,0 +1,357 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTrackingWindow   = 72 * time.Hour
+	defaultTrackerMaxPolls  = 100
+	trackerMessagesPageSize = 500
+)
+
+// DeliveryState is a delivery state of a transactional email
+type DeliveryState string
+
+const (
+	DeliveryStateQueued    DeliveryState = "queued"
+	DeliveryStateDelivered DeliveryState = "delivered"
+	DeliveryStateBounced   DeliveryState = "bounced"
+	// DeliveryStateExpired means SMTP history had no final answer within the tracking window or MaxPolls
+	DeliveryStateExpired DeliveryState = "expired"
+)
+
+// TrackedEmail represents a transactional email sent to a specific recipient
+type TrackedEmail struct {
+	CorrelationKey        string
+	SendID                string
+	MessageID             string
+	Recipient             string
+	Subject               string
+	SentAt                time.Time
+	State                 DeliveryState
+	SmtpAnswerCode        int
+	SmtpAnswerCodeExplain string
+	Opens                 int
+	Clicks                int
+	// Polls is the number of history requests made while the email was queued
+	Polls     int
+	UpdatedAt time.Time
+}
+
+// DeliveryEvent describes a change of a tracked email
+type DeliveryEvent struct {
+	Email         *TrackedEmail
+	PreviousState DeliveryState
+	NewOpens      int
+	NewClicks     int
+}
+
+// DeliveryStore persists tracked emails
+type DeliveryStore interface {
+	// Save replaces an email with the same correlation key and recipient
+	Save(ctx context.Context, email *TrackedEmail) error
+	// Get returns an email by the correlation key and recipient or nil if it isn't found
+	Get(ctx context.Context, correlationKey, recipient string) (*TrackedEmail, error)
+	// Pending returns queued emails and emails sent after since, which may still get opens and clicks
+	Pending(ctx context.Context, since time.Time) ([]*TrackedEmail, error)
+}
+
+// MemoryDeliveryStore is an in-memory DeliveryStore
+type MemoryDeliveryStore struct {
+	lock   sync.Mutex
+	emails map[string]TrackedEmail
+}
+
+// NewMemoryDeliveryStore creates MemoryDeliveryStore
+func NewMemoryDeliveryStore() *MemoryDeliveryStore {
+	return &MemoryDeliveryStore{emails: make(map[string]TrackedEmail)}
+}
+
+// Save stores the tracked email
+func (s *MemoryDeliveryStore) Save(ctx context.Context, email *TrackedEmail) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.emails[trackedEmailKey(email.CorrelationKey, email.Recipient)] = *email
+	return nil
+}
+
+// Get returns a tracked email or nil if it isn't found
+func (s *MemoryDeliveryStore) Get(ctx context.Context, correlationKey, recipient string) (*TrackedEmail, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	email, ok := s.emails[trackedEmailKey(correlationKey, recipient)]
+	if !ok {
+		return nil, nil
+	}
+	return &email, nil
+}
+
+// Pending returns queued emails and emails sent after since
+func (s *MemoryDeliveryStore) Pending(ctx context.Context, since time.Time) ([]*TrackedEmail, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var emails []*TrackedEmail
+	for _, email := range s.emails {
+		if email.State == DeliveryStateQueued || email.SentAt.After(since) {
+			email := email
+			emails = append(emails, &email)
+		}
+	}
+	return emails, nil
+}
+
+// DeliveryTracker correlates transactional emails with SMTP message history
+type DeliveryTracker struct {
+	smtp  *SmtpService
+	store DeliveryStore
+	// OnChange is called when a tracked email changes its state or gets new opens or clicks
+	OnChange func(event DeliveryEvent)
+	// TrackingWindow is a period after sending during which opens and clicks are tracked (default: 72 hours).
+	// Emails still queued after it expire
+	TrackingWindow time.Duration
+	// MaxPolls limits history requests for a queued email before it expires (default: 100)
+	MaxPolls int
+}
+
+// NewDeliveryTracker creates DeliveryTracker
+func NewDeliveryTracker(service *SmtpService, store DeliveryStore) *DeliveryTracker {
+	if store == nil {
+		store = NewMemoryDeliveryStore()
+	}
+	return &DeliveryTracker{
+		smtp:           service,
+		store:          store,
+		TrackingWindow: defaultTrackingWindow,
+		MaxPolls:       defaultTrackerMaxPolls,
+	}
+}
+
+// Send sends an email and starts tracking it for every To, Cc and Bcc recipient under the correlation key
+func (t *DeliveryTracker) Send(ctx context.Context, correlationKey string, params SendEmailParams) ([]*TrackedEmail, error) {
+	if correlationKey == "" {
+		return nil, fmt.Errorf("correlation key is empty")
+	}
+
+	sentAt := time.Now()
+	id, err := t.smtp.SendMessage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]User, 0, len(params.To)+len(params.Cc)+len(params.Bcc))
+	recipients = append(append(append(recipients, params.To...), params.Cc...), params.Bcc...)
+	emails := make([]*TrackedEmail, 0, len(recipients))
+	for _, recipient := range recipients {
+		email := &TrackedEmail{
+			CorrelationKey: correlationKey,
+			SendID:         id,
+			Recipient:      recipient.Email,
+			Subject:        params.Subject,
+			SentAt:         sentAt,
+			State:          DeliveryStateQueued,
+			UpdatedAt:      sentAt,
+		}
+		if err := t.store.Save(ctx, email); err != nil {
+			return emails, err
+		}
+		emails = append(emails, email)
+		t.emit(DeliveryEvent{Email: email})
+	}
+	return emails, nil
+}
+
+// Get returns a tracked email or nil if it isn't found
+func (t *DeliveryTracker) Get(ctx context.Context, correlationKey, recipient string) (*TrackedEmail, error) {
+	return t.store.Get(ctx, correlationKey, recipient)
+}
+
+// Poll requests SMTP message history for queued emails and for opens and clicks of delivered emails
+// within the tracking window. Queued emails expire after the tracking window or MaxPolls requests
+func (t *DeliveryTracker) Poll(ctx context.Context) error {
+	now := time.Now()
+	window := t.TrackingWindow
+	if window <= 0 {
+		window = defaultTrackingWindow
+	}
+	maxPolls := t.MaxPolls
+	if maxPolls <= 0 {
+		maxPolls = defaultTrackerMaxPolls
+	}
+	emails, err := t.store.Pending(ctx, now.Add(-window))
+	if err != nil {
+		return err
+	}
+
+	byRecipient := make(map[string][]*TrackedEmail)
+	var recipients []string
+	for _, email := range emails {
+		if email.State == DeliveryStateQueued && (now.Sub(email.SentAt) > window || email.Polls >= maxPolls) {
+			event := DeliveryEvent{Email: email, PreviousState: email.State}
+			email.State = DeliveryStateExpired
+			if err := t.save(ctx, email, event); err != nil {
+				return err
+			}
+			continue
+		}
+		if email.State != DeliveryStateQueued && email.State != DeliveryStateDelivered {
+			continue
+		}
+		key := strings.ToLower(email.Recipient)
+		if _, ok := byRecipient[key]; !ok {
+			recipients = append(recipients, key)
+		}
+		byRecipient[key] = append(byRecipient[key], email)
+	}
+
+	for _, recipient := range recipients {
+		tracked := byRecipient[recipient]
+		from := tracked[0].SentAt
+		for _, email := range tracked {
+			if email.SentAt.Before(from) {
+				from = email.SentAt
+			}
+		}
+
+		messages, err := t.recipientMessages(ctx, recipient, from.AddDate(0, 0, -1), now.AddDate(0, 0, 1))
+		if err != nil {
+			return err
+		}
+
+		for _, email := range tracked {
+			event := DeliveryEvent{Email: email, PreviousState: email.State}
+			if email.State == DeliveryStateQueued {
+				email.Polls++
+			}
+			if message := matchSmtpMessage(email, messages); message != nil {
+				event.NewOpens, event.NewClicks = applySmtpMessage(email, message)
+			}
+			if err := t.save(ctx, email, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Watch polls SMTP message history with the interval until the context is done
+func (t *DeliveryTracker) Watch(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := t.Poll(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// save stores a polled email and emits the event if the email changed. Unchanged emails are stored
+// only while queued to keep the number of polls
+func (t *DeliveryTracker) save(ctx context.Context, email *TrackedEmail, event DeliveryEvent) error {
+	changed := email.State != event.PreviousState || event.NewOpens > 0 || event.NewClicks > 0
+	if !changed && email.State != DeliveryStateQueued {
+		return nil
+	}
+	if changed {
+		email.UpdatedAt = time.Now()
+	}
+	if err := t.store.Save(ctx, email); err != nil {
+		return err
+	}
+	if changed {
+		t.emit(event)
+	}
+	return nil
+}
+
+// recipientMessages requests all pages of SMTP message history of the recipient
+func (t *DeliveryTracker) recipientMessages(ctx context.Context, recipient string, from, to time.Time) ([]*SmtpMessage, error) {
+	var messages []*SmtpMessage
+	for offset := 0; ; offset += trackerMessagesPageSize {
+		page, err := t.smtp.GetMessages(ctx, SmtpListParams{
+			Limit:     trackerMessagesPageSize,
+			Offset:    offset,
+			From:      from,
+			To:        to,
+			Recipient: recipient,
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, page...)
+		if len(page) < trackerMessagesPageSize {
+			return messages, nil
+		}
+	}
+}
+
+func (t *DeliveryTracker) emit(event DeliveryEvent) {
+	if t.OnChange != nil {
+		t.OnChange(event)
+	}
+}
+
+// matchSmtpMessage finds the SMTP message of the tracked email by its ID or by subject and send date
+func matchSmtpMessage(email *TrackedEmail, messages []*SmtpMessage) *SmtpMessage {
+	for _, message := range messages {
+		if message.ID == email.SendID || (email.MessageID != "" && message.ID == email.MessageID) {
+			return message
+		}
+	}
+
+	var match *SmtpMessage
+	for _, message := range messages {
+		if !strings.EqualFold(message.Recipient, email.Recipient) || message.Subject != email.Subject {
+			continue
+		}
+		if message.SendDate.Time().Before(email.SentAt.Add(-time.Minute)) {
+			continue
+		}
+		if match == nil || message.SendDate.Time().Before(match.SendDate.Time()) {
+			match = message
+		}
+	}
+	return match
+}
+
+// applySmtpMessage updates a tracked email with the SMTP message and returns the numbers of new opens and clicks
+func applySmtpMessage(email *TrackedEmail, message *SmtpMessage) (int, int) {
+	newOpens := message.Tracking.Open - email.Opens
+	newClicks := message.Tracking.Click - email.Clicks
+	email.MessageID = message.ID
+	email.SmtpAnswerCode = message.SmtpAnswerCode
+	email.SmtpAnswerCodeExplain = message.SmtpAnswerCodeExplain
+	email.State = smtpDeliveryState(message.SmtpAnswerCode)
+	if newOpens > 0 {
+		email.Opens = message.Tracking.Open
+	}
+	if newClicks > 0 {
+		email.Clicks = message.Tracking.Click
+	}
+	return newOpens, newClicks
+}
+
+// smtpDeliveryState converts an SMTP answer code to the delivery state. Temporary 4xx failures are still queued
+func smtpDeliveryState(code int) DeliveryState {
+	switch {
+	case code >= 200 && code < 300:
+		return DeliveryStateDelivered
+	case code >= 500:
+		return DeliveryStateBounced
+	default:
+		return DeliveryStateQueued
+	}
+}
+
+func trackedEmailKey(correlationKey, recipient string) string {
+	return correlationKey + "\x00" + strings.ToLower(recipient)
+}
@@ -0,0 +1,178 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestDeliveryTracker_SendAndPoll() {
+	suite.mux.HandleFunc("/smtp/emails", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprintf(w, `{"result": true, "id": "pzkic9-0afezp-fc"}`)
+			return
+		}
+		suite.Equal(http.MethodGet, r.Method)
+		switch r.URL.Query().Get("recipient") {
+		case "bob@test.com":
+			fmt.Fprintf(w, `[{
+				"id": "pzkic9-0afezp-fc",
+				"recipient": "bob@test.com",
+				"subject": "Order",
+				"smtp_answer_code": 250,
+				"smtp_answer_code_explain": "Delivered",
+				"send_date": "2018-10-10 12:54:45",
+				"tracking": {"click": 1, "open": 2}
+			}]`)
+		case "ann@test.com":
+			fmt.Fprintf(w, `[{
+				"id": "other",
+				"recipient": "ann@test.com",
+				"subject": "Order",
+				"smtp_answer_code": 550,
+				"smtp_answer_code_explain": "Mailbox unavailable",
+				"send_date": "2099-10-10 12:54:45",
+				"tracking": {"click": 0, "open": 0}
+			}]`)
+		}
+	})
+
+	var events []DeliveryEvent
+	tracker := NewDeliveryTracker(suite.client.SMTP, nil)
+	tracker.OnChange = func(event DeliveryEvent) {
+		events = append(events, event)
+	}
+
+	ctx := context.Background()
+	emails, err := tracker.Send(ctx, "order-1", SendEmailParams{
+		Html:    "<h1>Thanks</h1>",
+		Subject: "Order",
+		From:    User{Name: "Shop", Email: "shop@test.com"},
+		To:      []User{{Email: "bob@test.com"}},
+		Bcc:     []User{{Email: "ann@test.com"}},
+	})
+	suite.NoError(err)
+	suite.Len(emails, 2)
+	suite.Len(events, 2)
+
+	suite.NoError(tracker.Poll(ctx))
+	suite.Len(events, 4)
+
+	bob, err := tracker.Get(ctx, "order-1", "bob@test.com")
+	suite.NoError(err)
+	suite.Equal(DeliveryStateDelivered, bob.State)
+	suite.Equal(2, bob.Opens)
+	suite.Equal(1, bob.Clicks)
+
+	ann, err := tracker.Get(ctx, "order-1", "ann@test.com")
+	suite.NoError(err)
+	suite.Equal(DeliveryStateBounced, ann.State)
+	suite.Equal(DeliveryStateQueued, events[3].PreviousState)
+
+	suite.NoError(tracker.Poll(ctx))
+	suite.Len(events, 4)
+}
+
+func (suite *SendpulseTestSuite) TestDeliveryTracker_Paging() {
+	suite.mux.HandleFunc("/smtp/emails", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprintf(w, `{"result": true, "id": "sent-id"}`)
+			return
+		}
+		suite.Equal("500", r.URL.Query().Get("limit"))
+		suite.Equal("bob+shop@test.com", r.URL.Query().Get("recipient"))
+		if r.URL.Query().Get("offset") == "0" {
+			messages := make([]string, trackerMessagesPageSize)
+			for i := range messages {
+				messages[i] = fmt.Sprintf(`{"id": "other-%d", "recipient": "bob+shop@test.com", "subject": "Other", "smtp_answer_code": 250}`, i)
+			}
+			fmt.Fprintf(w, "[%s]", strings.Join(messages, ","))
+			return
+		}
+		fmt.Fprintf(w, `[{"id": "sent-id", "recipient": "bob+shop@test.com", "subject": "Order", "smtp_answer_code": 250}]`)
+	})
+
+	ctx := context.Background()
+	tracker := NewDeliveryTracker(suite.client.SMTP, nil)
+	_, err := tracker.Send(ctx, "order-1", SendEmailParams{Subject: "Order", To: []User{{Email: "bob+shop@test.com"}}})
+	suite.NoError(err)
+	suite.NoError(tracker.Poll(ctx))
+
+	bob, err := tracker.Get(ctx, "order-1", "bob+shop@test.com")
+	suite.NoError(err)
+	suite.Equal(DeliveryStateDelivered, bob.State)
+}
+
+func (suite *SendpulseTestSuite) TestDeliveryTracker_TrackingWindow() {
+	requests := 0
+	suite.mux.HandleFunc("/smtp/emails", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `[]`)
+	})
+
+	store := NewMemoryDeliveryStore()
+	ctx := context.Background()
+	suite.NoError(store.Save(ctx, &TrackedEmail{
+		CorrelationKey: "old",
+		Recipient:      "bob@test.com",
+		SentAt:         time.Now().Add(-100 * time.Hour),
+		State:          DeliveryStateDelivered,
+		Opens:          1,
+	}))
+
+	tracker := NewDeliveryTracker(suite.client.SMTP, store)
+	suite.NoError(tracker.Poll(ctx))
+	suite.Equal(0, requests)
+
+	email, err := tracker.Get(ctx, "old", "bob@test.com")
+	suite.NoError(err)
+	suite.Equal(DeliveryStateDelivered, email.State)
+	suite.Equal(1, email.Opens)
+}
+
+func (suite *SendpulseTestSuite) TestDeliveryTracker_Expiry() {
+	requests := 0
+	suite.mux.HandleFunc("/smtp/emails", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprintf(w, `{"result": true, "id": "sent-id"}`)
+			return
+		}
+		requests++
+		fmt.Fprintf(w, `[]`)
+	})
+
+	store := NewMemoryDeliveryStore()
+	ctx := context.Background()
+	suite.NoError(store.Save(ctx, &TrackedEmail{
+		CorrelationKey: "old",
+		Recipient:      "ann@test.com",
+		SentAt:         time.Now().Add(-100 * time.Hour),
+		State:          DeliveryStateQueued,
+	}))
+
+	var events []DeliveryEvent
+	tracker := NewDeliveryTracker(suite.client.SMTP, store)
+	tracker.MaxPolls = 2
+	tracker.OnChange = func(event DeliveryEvent) {
+		events = append(events, event)
+	}
+	_, err := tracker.Send(ctx, "order-1", SendEmailParams{Subject: "Order", To: []User{{Email: "bob@test.com"}}})
+	suite.NoError(err)
+
+	for i := 0; i < 4; i++ {
+		suite.NoError(tracker.Poll(ctx))
+	}
+	suite.Equal(2, requests)
+
+	old, err := tracker.Get(ctx, "old", "ann@test.com")
+	suite.NoError(err)
+	suite.Equal(DeliveryStateExpired, old.State)
+
+	bob, err := tracker.Get(ctx, "order-1", "bob@test.com")
+	suite.NoError(err)
+	suite.Equal(DeliveryStateExpired, bob.State)
+	suite.Equal(2, bob.Polls)
+	suite.Len(events, 3)
+}
@@ -0,0 +1,248 @@
+package sendpulse_sdk_go
+
+import "fmt"
+
+// MailingListStatus is a status of a mailing list
+type MailingListStatus int
+
+const (
+	MailingListStatusActive     MailingListStatus = 0
+	MailingListStatusModeration MailingListStatus = 1
+	MailingListStatusBlocked    MailingListStatus = 2
+)
+
+var mailingListStatusNames = map[int]string{
+	int(MailingListStatusActive):     "Active",
+	int(MailingListStatusModeration): "Moderation",
+	int(MailingListStatusBlocked):    "Blocked",
+}
+
+// String returns a name of the status
+func (s MailingListStatus) String() string {
+	return statusName(mailingListStatusNames, int(s))
+}
+
+// IsActive reports whether emails can be sent to the mailing list
+func (s MailingListStatus) IsActive() bool {
+	return s == MailingListStatusActive
+}
+
+// CampaignStatus is a status of an email campaign
+type CampaignStatus int
+
+const (
+	CampaignStatusNew        CampaignStatus = 0
+	CampaignStatusSending    CampaignStatus = 1
+	CampaignStatusSent       CampaignStatus = 3
+	CampaignStatusRejected   CampaignStatus = 4
+	CampaignStatusCanceled   CampaignStatus = 5
+	CampaignStatusModeration CampaignStatus = 13
+)
+
+var campaignStatusNames = map[int]string{
+	int(CampaignStatusNew):        "New",
+	int(CampaignStatusSending):    "Sending",
+	int(CampaignStatusSent):       "Sent",
+	int(CampaignStatusRejected):   "Rejected",
+	int(CampaignStatusCanceled):   "Canceled",
+	int(CampaignStatusModeration): "Moderation",
+}
+
+// String returns a name of the status
+func (s CampaignStatus) String() string {
+	return statusName(campaignStatusNames, int(s))
+}
+
+// IsFinal reports whether the campaign will not change its status anymore
+func (s CampaignStatus) IsFinal() bool {
+	return s == CampaignStatusSent || s == CampaignStatusRejected || s == CampaignStatusCanceled
+}
+
+// IsActive reports whether the campaign is being moderated or sent
+func (s CampaignStatus) IsActive() bool {
+	return s == CampaignStatusModeration || s == CampaignStatusSending
+}
+
+// IsCancelable reports whether the campaign can be canceled
+func (s CampaignStatus) IsCancelable() bool {
+	return s == CampaignStatusNew || s == CampaignStatusModeration
+}
+
+// PushStatus is a status of a web push campaign
+type PushStatus int
+
+const (
+	PushStatusNew      PushStatus = 0
+	PushStatusSending  PushStatus = 1
+	PushStatusSent     PushStatus = 3
+	PushStatusCanceled PushStatus = 5
+)
+
+var pushStatusNames = map[int]string{
+	int(PushStatusNew):      "New",
+	int(PushStatusSending):  "Sending",
+	int(PushStatusSent):     "Sent",
+	int(PushStatusCanceled): "Canceled",
+}
+
+// String returns a name of the status
+func (s PushStatus) String() string {
+	return statusName(pushStatusNames, int(s))
+}
+
+// IsFinal reports whether the push campaign will not change its status anymore
+func (s PushStatus) IsFinal() bool {
+	return s == PushStatusSent || s == PushStatusCanceled
+}
+
+// SubscriberStatus is a status of an email address or a phone in a mailing list
+type SubscriberStatus int
+
+const (
+	SubscriberStatusNew          SubscriberStatus = 0
+	SubscriberStatusActive       SubscriberStatus = 1
+	SubscriberStatusUnsubscribed SubscriberStatus = 2
+	SubscriberStatusInactive     SubscriberStatus = 3
+)
+
+var subscriberStatusNames = map[int]string{
+	int(SubscriberStatusNew):          "New",
+	int(SubscriberStatusActive):       "Active",
+	int(SubscriberStatusUnsubscribed): "Unsubscribed",
+	int(SubscriberStatusInactive):     "Inactive",
+}
+
+// String returns a name of the status
+func (s SubscriberStatus) String() string {
+	return statusName(subscriberStatusNames, int(s))
+}
+
+// IsActive reports whether the subscriber receives campaigns
+func (s SubscriberStatus) IsActive() bool {
+	return s == SubscriberStatusNew || s == SubscriberStatusActive
+}
+
+// SmsSenderStatus is a status of an SMS sender name
+type SmsSenderStatus int
+
+const (
+	SmsSenderStatusModeration SmsSenderStatus = 0
+	SmsSenderStatusActive     SmsSenderStatus = 1
+	SmsSenderStatusRejected   SmsSenderStatus = 2
+)
+
+var smsSenderStatusNames = map[int]string{
+	int(SmsSenderStatusModeration): "Moderation",
+	int(SmsSenderStatusActive):     "Active",
+	int(SmsSenderStatusRejected):   "Rejected",
+}
+
+// String returns a name of the status
+func (s SmsSenderStatus) String() string {
+	return statusName(smsSenderStatusNames, int(s))
+}
+
+// IsActive reports whether the sender name can be used in campaigns
+func (s SmsSenderStatus) IsActive() bool {
+	return s == SmsSenderStatusActive
+}
+
+// IsFinal reports whether the moderation of the sender name is finished
+func (s SmsSenderStatus) IsFinal() bool {
+	return s == SmsSenderStatusActive || s == SmsSenderStatusRejected
+}
+
+// EmailValidationStatus is a result of an email address verification
+type EmailValidationStatus int
+
+const (
+	EmailValidationStatusUnverified  EmailValidationStatus = 0
+	EmailValidationStatusValid       EmailValidationStatus = 1
+	EmailValidationStatusUnconfirmed EmailValidationStatus = 2
+	EmailValidationStatusInvalid     EmailValidationStatus = 3
+)
+
+var emailValidationStatusNames = map[int]string{
+	int(EmailValidationStatusUnverified):  "Unverified",
+	int(EmailValidationStatusValid):       "Valid",
+	int(EmailValidationStatusUnconfirmed): "Unconfirmed",
+	int(EmailValidationStatusInvalid):     "Invalid",
+}
+
+// String returns a name of the status
+func (s EmailValidationStatus) String() string {
+	return statusName(emailValidationStatusNames, int(s))
+}
+
+// IsFinal reports whether the email address is verified
+func (s EmailValidationStatus) IsFinal() bool {
+	return s != EmailValidationStatusUnverified
+}
+
+// IsValid reports whether the email address is confirmed to be valid
+func (s EmailValidationStatus) IsValid() bool {
+	return s == EmailValidationStatusValid
+}
+
+// BotContactStatus is a status of a chatbot contact
+type BotContactStatus int
+
+const (
+	BotContactStatusActive       BotContactStatus = 1
+	BotContactStatusDisabled     BotContactStatus = 2
+	BotContactStatusUnsubscribed BotContactStatus = 3
+	BotContactStatusUnconfirmed  BotContactStatus = 4
+)
+
+var botContactStatusNames = map[int]string{
+	int(BotContactStatusActive):       "Active",
+	int(BotContactStatusDisabled):     "Disabled",
+	int(BotContactStatusUnsubscribed): "Unsubscribed",
+	int(BotContactStatusUnconfirmed):  "Unconfirmed",
+}
+
+// String returns a name of the status
+func (s BotContactStatus) String() string {
+	return statusName(botContactStatusNames, int(s))
+}
+
+// IsActive reports whether messages can be sent to the contact
+func (s BotContactStatus) IsActive() bool {
+	return s == BotContactStatusActive
+}
+
+// MessageDirection is a direction of a chatbot message
+type MessageDirection int
+
+const (
+	MessageDirectionIncoming MessageDirection = 1
+	MessageDirectionOutgoing MessageDirection = 2
+)
+
+var messageDirectionNames = map[int]string{
+	int(MessageDirectionIncoming): "Incoming",
+	int(MessageDirectionOutgoing): "Outgoing",
+}
+
+// String returns a name of the direction
+func (d MessageDirection) String() string {
+	return statusName(messageDirectionNames, int(d))
+}
+
+// IsIncoming reports whether the message is sent by the contact
+func (d MessageDirection) IsIncoming() bool {
+	return d == MessageDirectionIncoming
+}
+
+// IsOutgoing reports whether the message is sent by the bot
+func (d MessageDirection) IsOutgoing() bool {
+	return d == MessageDirectionOutgoing
+}
+
+// statusName returns a name of a status or a placeholder for unknown values
+func statusName(names map[int]string, value int) string {
+	if name, ok := names[value]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", value)
+}
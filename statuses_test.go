@@ -0,0 +1,45 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (suite *SendpulseTestSuite) TestStatuses_String() {
+	suite.Equal("Active", MailingListStatusActive.String())
+	suite.Equal("Moderation", CampaignStatus(13).String())
+	suite.Equal("Unknown(42)", CampaignStatus(42).String())
+	suite.Equal("Rejected", SmsSenderStatusRejected.String())
+	suite.Equal("Sent", PushStatus(3).String())
+	suite.Equal("Invalid", EmailValidationStatus(3).String())
+	suite.Equal("Outgoing", MessageDirectionOutgoing.String())
+	suite.Equal("Active", fmt.Sprint(BotContactStatusActive))
+}
+
+func (suite *SendpulseTestSuite) TestStatuses_Predicates() {
+	suite.True(CampaignStatusSent.IsFinal())
+	suite.False(CampaignStatusModeration.IsFinal())
+	suite.True(CampaignStatusModeration.IsActive())
+	suite.True(CampaignStatusNew.IsCancelable())
+	suite.True(PushStatusSent.IsFinal())
+	suite.False(PushStatusSending.IsFinal())
+	suite.True(SubscriberStatusNew.IsActive())
+	suite.False(SubscriberStatusUnsubscribed.IsActive())
+	suite.True(SmsSenderStatusRejected.IsFinal())
+	suite.False(SmsSenderStatusModeration.IsActive())
+	suite.True(EmailValidationStatusValid.IsValid())
+	suite.False(EmailValidationStatusUnverified.IsFinal())
+	suite.True(MessageDirectionIncoming.IsIncoming())
+}
+
+func (suite *SendpulseTestSuite) TestStatuses_Decode() {
+	suite.mux.HandleFunc("/campaigns/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "status": 3}`)
+	})
+
+	campaign, err := suite.client.Emails.Campaigns.GetCampaign(context.Background(), 1)
+	suite.NoError(err)
+	suite.Equal(CampaignStatusSent, campaign.Status)
+	suite.True(campaign.Status.IsFinal())
+}
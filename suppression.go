@@ -0,0 +1,370 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSuppressionCacheTTL      = 5 * time.Minute
+	defaultSuppressionListThreshold = 500
+)
+
+// SuppressionChannel is a channel a person can be suppressed in
+type SuppressionChannel string
+
+const (
+	// SuppressionChannelEmail is the email blacklist used by campaigns
+	SuppressionChannelEmail SuppressionChannel = "email"
+	// SuppressionChannelSmtp is the unsubscribe list of transactional emails
+	SuppressionChannelSmtp SuppressionChannel = "smtp"
+	// SuppressionChannelSms is the SMS blacklist
+	SuppressionChannelSms SuppressionChannel = "sms"
+)
+
+// Suppression describes a suppressed address
+type Suppression struct {
+	Channel SuppressionChannel `json:"channel"`
+	Address string             `json:"address"`
+	Comment string             `json:"comment,omitempty"`
+	AddDate DateTimeType       `json:"add_date"`
+}
+
+// SuppressionOptions configures SuppressionManager
+type SuppressionOptions struct {
+	// CacheTTL is a lifetime of downloaded suppression lists (default: 5 minutes)
+	CacheTTL time.Duration
+	// ListThreshold is a number of emails from which the email blacklist is downloaded and cached
+	// instead of checking the emails with a request per 100 emails (default: 500)
+	ListThreshold int
+}
+
+// suppressionList is a downloaded suppression list of emails. done is closed when it's loaded
+type suppressionList struct {
+	done     chan struct{}
+	emails   map[string]bool
+	err      error
+	loadedAt time.Time
+}
+
+// SuppressionManager checks and manages suppressions across email, SMTP and SMS channels.
+// Emails are checked against the blacklist with a request per 100 emails, batches of ListThreshold emails
+// and more download the blacklist page by page. The SMTP unsubscribe list can't be checked per address,
+// so it's downloaded on the first SMTP check. Downloaded lists are shared by concurrent checks and reused
+// for CacheTTL. Phones are checked per request
+type SuppressionManager struct {
+	client  *Client
+	options SuppressionOptions
+	lock    sync.Mutex
+	lists   map[SuppressionChannel]*suppressionList
+}
+
+// NewSuppressionManager creates SuppressionManager
+func NewSuppressionManager(client *Client, options SuppressionOptions) *SuppressionManager {
+	if options.CacheTTL <= 0 {
+		options.CacheTTL = defaultSuppressionCacheTTL
+	}
+	if options.ListThreshold <= 0 {
+		options.ListThreshold = defaultSuppressionListThreshold
+	}
+	return &SuppressionManager{
+		client:  client,
+		options: options,
+		lists:   make(map[SuppressionChannel]*suppressionList),
+	}
+}
+
+// MayContact reports whether an address may be contacted on the channel.
+// Transactional emails are checked against both the SMTP unsubscribe list and the email blacklist,
+// so the first SMTP check downloads the SMTP unsubscribe list
+func (m *SuppressionManager) MayContact(ctx context.Context, channel SuppressionChannel, address string) (bool, error) {
+	allowed, _, err := m.FilterContactable(ctx, channel, []string{address})
+	if err != nil {
+		return false, err
+	}
+	return len(allowed) == 1, nil
+}
+
+// FilterContactable splits addresses into allowed and suppressed ones for the channel
+func (m *SuppressionManager) FilterContactable(ctx context.Context, channel SuppressionChannel, addresses []string) ([]string, []string, error) {
+	suppressed, err := m.suppressedSet(ctx, channel, addresses)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowed, denied []string
+	for _, address := range addresses {
+		key, err := m.addressKey(channel, address)
+		if err != nil {
+			return nil, nil, err
+		}
+		if suppressed[key] {
+			denied = append(denied, address)
+		} else {
+			allowed = append(allowed, address)
+		}
+	}
+	return allowed, denied, nil
+}
+
+// Suppress adds an address to suppression lists of the channels.
+// Without channels emails are suppressed in email and SMTP channels and phones in the SMS channel
+func (m *SuppressionManager) Suppress(ctx context.Context, address string, comment string, channels ...SuppressionChannel) error {
+	for _, channel := range defaultSuppressionChannels(address, channels) {
+		var err error
+		switch channel {
+		case SuppressionChannelEmail:
+			err = m.client.Emails.Blacklist.AddToBlacklist(ctx, []string{address}, comment)
+		case SuppressionChannelSmtp:
+			err = m.client.SMTP.UnsubscribeEmails(ctx, []*SmtpUnsubscribeEmail{{Email: address, Comment: comment}})
+		case SuppressionChannelSms:
+			var phone string
+			if phone, err = m.client.preparePhone(address); err == nil {
+				err = m.client.SMS.AddToBlacklist(ctx, []string{phone}, comment)
+			}
+		default:
+			err = fmt.Errorf("unsupported suppression channel %q", channel)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", channel, err)
+		}
+		m.forget(channel)
+	}
+	return nil
+}
+
+// Unsuppress removes an address from suppression lists of the channels.
+// Without channels emails are removed from email and SMTP channels and phones from the SMS channel
+func (m *SuppressionManager) Unsuppress(ctx context.Context, address string, channels ...SuppressionChannel) error {
+	for _, channel := range defaultSuppressionChannels(address, channels) {
+		var err error
+		switch channel {
+		case SuppressionChannelEmail:
+			err = m.client.Emails.Blacklist.RemoveFromBlacklist(ctx, []string{address})
+		case SuppressionChannelSmtp:
+			err = m.client.SMTP.DeleteUnsubscribedEmails(ctx, []string{address})
+		case SuppressionChannelSms:
+			var phone string
+			if phone, err = m.client.preparePhone(address); err == nil {
+				err = m.client.SMS.RemoveFromBlacklist(ctx, []string{phone})
+			}
+		default:
+			err = fmt.Errorf("unsupported suppression channel %q", channel)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", channel, err)
+		}
+		m.forget(channel)
+	}
+	return nil
+}
+
+// Export returns the combined suppression list of all channels ordered by channel and address
+func (m *SuppressionManager) Export(ctx context.Context) ([]*Suppression, error) {
+	var suppressions []*Suppression
+
+	blacklist, err := m.client.Emails.Blacklist.GetAllBlacklist(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range blacklist {
+		suppressions = append(suppressions, &Suppression{
+			Channel: SuppressionChannelEmail,
+			Address: entry.Email,
+			Comment: entry.Comment,
+			AddDate: entry.AddDate,
+		})
+	}
+
+	unsubscribed, err := m.client.SMTP.GetAllUnsubscribedEmails(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range unsubscribed {
+		suppressions = append(suppressions, &Suppression{
+			Channel: SuppressionChannelSmtp,
+			Address: item.Email,
+			Comment: smtpUnsubscribeReason(item),
+			AddDate: item.Date,
+		})
+	}
+
+	phones, err := m.client.SMS.GetBlacklist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, phone := range phones {
+		suppressions = append(suppressions, &Suppression{
+			Channel: SuppressionChannelSms,
+			Address: phone.Phone,
+			Comment: phone.Description,
+			AddDate: phone.AddDate,
+		})
+	}
+
+	sort.SliceStable(suppressions, func(i, j int) bool {
+		if suppressions[i].Channel != suppressions[j].Channel {
+			return suppressions[i].Channel < suppressions[j].Channel
+		}
+		return suppressions[i].Address < suppressions[j].Address
+	})
+	return suppressions, nil
+}
+
+// suppressedSet returns keys of suppressed addresses among the given ones
+func (m *SuppressionManager) suppressedSet(ctx context.Context, channel SuppressionChannel, addresses []string) (map[string]bool, error) {
+	suppressed := make(map[string]bool)
+	switch channel {
+	case SuppressionChannelEmail, SuppressionChannelSmtp:
+		if len(addresses) < m.options.ListThreshold {
+			blacklisted, err := m.client.Emails.Blacklist.IsBlacklisted(ctx, addresses)
+			if err != nil {
+				return nil, err
+			}
+			for address, ok := range blacklisted {
+				if ok {
+					suppressed[normalizeEmail(address)] = true
+				}
+			}
+		} else if err := m.addSuppressedEmails(ctx, SuppressionChannelEmail, addresses, suppressed); err != nil {
+			return nil, err
+		}
+		if channel == SuppressionChannelSmtp {
+			if err := m.addSuppressedEmails(ctx, SuppressionChannelSmtp, addresses, suppressed); err != nil {
+				return nil, err
+			}
+		}
+	case SuppressionChannelSms:
+		phones := make([]string, 0, len(addresses))
+		for _, address := range addresses {
+			phone, err := m.addressKey(channel, address)
+			if err != nil {
+				return nil, err
+			}
+			phones = append(phones, phone)
+		}
+		if len(phones) == 0 {
+			return suppressed, nil
+		}
+		blacklisted, err := m.client.SMS.GetBlacklistedPhones(ctx, phones)
+		if err != nil {
+			return nil, err
+		}
+		for _, phone := range blacklisted {
+			suppressed[phone.Phone] = true
+		}
+	default:
+		return nil, fmt.Errorf("unsupported suppression channel %q", channel)
+	}
+	return suppressed, nil
+}
+
+// addSuppressedEmails marks the addresses found in the downloaded list of the channel as suppressed
+func (m *SuppressionManager) addSuppressedEmails(ctx context.Context, channel SuppressionChannel, addresses []string, suppressed map[string]bool) error {
+	emails, err := m.suppressedEmails(ctx, channel)
+	if err != nil {
+		return err
+	}
+	for _, address := range addresses {
+		if key := normalizeEmail(address); emails[key] {
+			suppressed[key] = true
+		}
+	}
+	return nil
+}
+
+// suppressedEmails returns normalized emails of the blacklist or the SMTP unsubscribe list
+// downloading the list if it isn't cached or is older than CacheTTL. Concurrent calls wait for one download
+func (m *SuppressionManager) suppressedEmails(ctx context.Context, channel SuppressionChannel) (map[string]bool, error) {
+	m.lock.Lock()
+	list := m.lists[channel]
+	if list == nil || list.expired(m.options.CacheTTL) {
+		list = &suppressionList{done: make(chan struct{})}
+		m.lists[channel] = list
+		m.lock.Unlock()
+		list.emails, list.err = m.downloadEmails(ctx, channel)
+		list.loadedAt = time.Now()
+		close(list.done)
+		return list.emails, list.err
+	}
+	m.lock.Unlock()
+
+	select {
+	case <-list.done:
+		return list.emails, list.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// downloadEmails downloads the blacklist or the SMTP unsubscribe list page by page
+func (m *SuppressionManager) downloadEmails(ctx context.Context, channel SuppressionChannel) (map[string]bool, error) {
+	if channel != SuppressionChannelSmtp {
+		return m.client.Emails.Blacklist.GetBlacklistSet(ctx)
+	}
+	unsubscribed, err := m.client.SMTP.GetAllUnsubscribedEmails(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	emails := make(map[string]bool, len(unsubscribed))
+	for _, item := range unsubscribed {
+		emails[normalizeEmail(item.Email)] = true
+	}
+	return emails, nil
+}
+
+// expired reports whether a loaded list is older than ttl or failed to load
+func (l *suppressionList) expired(ttl time.Duration) bool {
+	select {
+	case <-l.done:
+		return l.err != nil || time.Since(l.loadedAt) >= ttl
+	default:
+		return false
+	}
+}
+
+// forget drops the cached list of the channel after it's changed
+func (m *SuppressionManager) forget(channel SuppressionChannel) {
+	m.lock.Lock()
+	delete(m.lists, channel)
+	m.lock.Unlock()
+}
+
+// addressKey normalizes an address of the channel for comparison. Phones are compared by digits only
+func (m *SuppressionManager) addressKey(channel SuppressionChannel, address string) (string, error) {
+	if channel != SuppressionChannelSms {
+		return normalizeEmail(address), nil
+	}
+	phone, err := m.client.preparePhone(address)
+	if err != nil {
+		return "", err
+	}
+	digits, _, err := cleanPhone(phone)
+	return digits, err
+}
+
+func defaultSuppressionChannels(address string, channels []SuppressionChannel) []SuppressionChannel {
+	if len(channels) != 0 {
+		return channels
+	}
+	if strings.Contains(address, "@") {
+		return []SuppressionChannel{SuppressionChannelEmail, SuppressionChannelSmtp}
+	}
+	return []SuppressionChannel{SuppressionChannelSms}
+}
+
+func smtpUnsubscribeReason(item Unsubscribed) string {
+	switch {
+	case item.SpamComplaint != 0:
+		return "spam complaint"
+	case item.UnsubscribeByLink != 0:
+		return "unsubscribed by link"
+	case item.UnsubscribeByUser != 0:
+		return "unsubscribed by user"
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+func (suite *SendpulseTestSuite) handleSuppressions() map[string]int {
+	calls := make(map[string]int)
+	suite.mux.HandleFunc("/blacklist", func(w http.ResponseWriter, r *http.Request) {
+		calls["blacklist."+r.Method]++
+		if r.Method != http.MethodGet {
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		fmt.Fprintf(w, `[{"email": "blocked@test.com", "comment": "bounce", "add_date": "2021-01-01 10:00:00"}]`)
+	})
+	suite.mux.HandleFunc("/emails/campaigns", func(w http.ResponseWriter, r *http.Request) {
+		calls["statistics"]++
+		var body struct {
+			Emails []string `json:"emails"`
+		}
+		suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+		statistics := make(map[string]interface{})
+		for _, email := range body.Emails {
+			statistics[email] = map[string]bool{"blacklist": strings.EqualFold(email, "blocked@test.com")}
+		}
+		suite.NoError(json.NewEncoder(w).Encode(statistics))
+	})
+	suite.mux.HandleFunc("/smtp/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		calls["smtp."+r.Method]++
+		if r.Method != http.MethodGet {
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		fmt.Fprintf(w, `[{"email": "unsub@test.com", "spam_complaint": 1, "date": "2021-02-01 10:00:00"}]`)
+	})
+	suite.mux.HandleFunc("/sms/black_list", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			calls["sms."+r.Method]++
+			fmt.Fprintf(w, `{"result": true}`)
+			return
+		}
+		fmt.Fprintf(w, `{"result": true, "data": [{"phone": 380931112233, "description": "stop", "add_date": "2021-03-01 10:00:00"}]}`)
+	})
+	suite.mux.HandleFunc("/sms/black_list/by_numbers", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result": true, "data": [{"phone": 380931112233, "description": "stop"}]}`)
+	})
+	return calls
+}
+
+func (suite *SendpulseTestSuite) TestSuppressionManager_MayContact() {
+	calls := suite.handleSuppressions()
+	manager := NewSuppressionManager(suite.client, SuppressionOptions{})
+
+	ok, err := manager.MayContact(context.Background(), SuppressionChannelEmail, "Blocked@test.com")
+	suite.NoError(err)
+	suite.False(ok)
+
+	ok, err = manager.MayContact(context.Background(), SuppressionChannelEmail, "unsub@test.com")
+	suite.NoError(err)
+	suite.True(ok)
+
+	ok, err = manager.MayContact(context.Background(), SuppressionChannelSmtp, "unsub@test.com")
+	suite.NoError(err)
+	suite.False(ok)
+	ok, err = manager.MayContact(context.Background(), SuppressionChannelSmtp, "alex@test.com")
+	suite.NoError(err)
+	suite.True(ok)
+	suite.Equal(1, calls["smtp.GET"])
+	suite.Equal(0, calls["blacklist.GET"])
+	suite.Equal(4, calls["statistics"])
+
+	suite.NoError(manager.Unsuppress(context.Background(), "unsub@test.com", SuppressionChannelSmtp))
+	_, err = manager.MayContact(context.Background(), SuppressionChannelSmtp, "alex@test.com")
+	suite.NoError(err)
+	suite.Equal(2, calls["smtp.GET"])
+
+	allowed, suppressed, err := manager.FilterContactable(context.Background(), SuppressionChannelSms, []string{"380 93 111 22 33", "380501112233"})
+	suite.NoError(err)
+	suite.Equal([]string{"380501112233"}, allowed)
+	suite.Equal([]string{"380 93 111 22 33"}, suppressed)
+}
+
+func (suite *SendpulseTestSuite) TestSuppressionManager_FilterContactableList() {
+	calls := suite.handleSuppressions()
+	manager := NewSuppressionManager(suite.client, SuppressionOptions{ListThreshold: 2})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			allowed, suppressed, err := manager.FilterContactable(context.Background(), SuppressionChannelEmail, []string{"alex@test.com", "BLOCKED@test.com"})
+			suite.NoError(err)
+			suite.Equal([]string{"alex@test.com"}, allowed)
+			suite.Equal([]string{"BLOCKED@test.com"}, suppressed)
+		}()
+	}
+	wg.Wait()
+	suite.Equal(1, calls["blacklist.GET"])
+	suite.Equal(0, calls["statistics"])
+}
+
+func (suite *SendpulseTestSuite) TestSuppressionManager_Suppress() {
+	calls := suite.handleSuppressions()
+	manager := NewSuppressionManager(suite.client, SuppressionOptions{})
+
+	suite.NoError(manager.Suppress(context.Background(), "alex@test.com", "request"))
+	suite.Equal(1, calls["blacklist.POST"])
+	suite.Equal(1, calls["smtp.POST"])
+
+	suite.NoError(manager.Suppress(context.Background(), "380931112233", "request"))
+	suite.Equal(1, calls["sms.POST"])
+
+	suite.NoError(manager.Unsuppress(context.Background(), "alex@test.com", SuppressionChannelSmtp))
+	suite.Equal(1, calls["smtp.DELETE"])
+	suite.Equal(0, calls["blacklist.DELETE"])
+	suite.Equal(0, calls["blacklist.GET"])
+
+	suite.Error(manager.Suppress(context.Background(), "alex@test.com", "", "push"))
+}
+
+func (suite *SendpulseTestSuite) TestSuppressionManager_Export() {
+	suite.handleSuppressions()
+
+	suppressions, err := NewSuppressionManager(suite.client, SuppressionOptions{}).Export(context.Background())
+	suite.NoError(err)
+	suite.Len(suppressions, 3)
+	suite.Equal(SuppressionChannelEmail, suppressions[0].Channel)
+	suite.Equal("bounce", suppressions[0].Comment)
+	suite.Equal(SuppressionChannelSms, suppressions[1].Channel)
+	suite.Equal("380931112233", suppressions[1].Address)
+	suite.Equal(SuppressionChannelSmtp, suppressions[2].Channel)
+	suite.Equal("spam complaint", suppressions[2].Comment)
+}
@@ -2,34 +2,161 @@ package sendpulse_sdk_go
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// DateTimeType represents a date and time in SendPulse format ("2006-01-02 15:04:05").
+// SendPulse dates have no timezone: Client parses them in the account location (Config.Location
+// or Client.SetAccountTimezone, default: UTC) and formats dates of requests in that location
 type DateTimeType time.Time
 
 const dtFormat = "2006-01-02 15:04:05"
 
+var (
+	dateTimeReflectType  = reflect.TypeOf(DateTimeType{})
+	timezoneOffsetRegexp = regexp.MustCompile(`^\(?(?:UTC|GMT)\s*(?:([+-])(\d{1,2})(?::?(\d{2}))?)?\)?`)
+)
+
+// NewDateTime creates DateTimeType from time.Time
+func NewDateTime(t time.Time) DateTimeType {
+	return DateTimeType(t)
+}
+
+// ParseDateTime parses a date in SendPulse format in the location (default: UTC)
+func ParseDateTime(s string, loc *time.Location) (DateTimeType, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation(dtFormat, s, loc)
+	if err != nil {
+		return DateTimeType{}, err
+	}
+	return DateTimeType(t), nil
+}
+
+// Time converts DateTimeType to time.Time
+func (d DateTimeType) Time() time.Time {
+	return time.Time(d)
+}
+
+// In returns the time in the specific location
+func (d DateTimeType) In(loc *time.Location) time.Time {
+	return time.Time(d).In(loc)
+}
+
+// IsZero reports whether the date is not set
+func (d DateTimeType) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+// Format returns the date in SendPulse format in the location of the date
+func (d DateTimeType) Format() string {
+	return time.Time(d).Format(dtFormat)
+}
+
+// UnmarshalJSON parses the date in UTC. Client moves decoded dates to the account location
 func (d *DateTimeType) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
-	if s == "null" {
+	if s == "null" || s == "" || s == "0000-00-00 00:00:00" {
 		*d = DateTimeType(time.Time{})
 		return nil
 	}
-	t, err := time.Parse(dtFormat, s)
+	t, err := ParseDateTime(s, time.UTC)
 	if err != nil {
 		return err
 	}
 
-	*d = DateTimeType(t)
+	*d = t
 	return nil
 }
 
-func (d *DateTimeType) MarshalJSON() ([]byte, error) {
-	return []byte(d.String()), nil
+// MarshalJSON returns the date in SendPulse format or null if the date is not set
+func (d DateTimeType) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(fmt.Sprintf("%q", d.Format())), nil
+}
+
+// String returns the quoted date in SendPulse format
+func (d DateTimeType) String() string {
+	return fmt.Sprintf("%q", d.Format())
 }
 
-func (d *DateTimeType) String() string {
-	t := time.Time(*d)
-	return fmt.Sprintf("%q", t.Format(dtFormat))
+// ptr returns a pointer to the date or nil if the date is not set. It is used to omit unset dates in requests
+func (d DateTimeType) ptr() *DateTimeType {
+	if d.IsZero() {
+		return nil
+	}
+	return &d
+}
+
+// withWallClockIn returns the date with the same wall clock in the location
+func (d DateTimeType) withWallClockIn(loc *time.Location) DateTimeType {
+	t := time.Time(d)
+	return DateTimeType(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc))
+}
+
+// localizeDates moves dates decoded in UTC to the location keeping their wall clock
+func localizeDates(v reflect.Value, loc *time.Location) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			localizeDates(v.Elem(), loc)
+		}
+	case reflect.Struct:
+		if v.Type() == dateTimeReflectType {
+			if v.CanSet() && !v.Interface().(DateTimeType).IsZero() {
+				v.Set(reflect.ValueOf(v.Interface().(DateTimeType).withWallClockIn(loc)))
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Type().Field(i); field.PkgPath == "" || field.Anonymous {
+				localizeDates(v.Field(i), loc)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			localizeDates(v.Index(i), loc)
+		}
+	case reflect.Map:
+		if !v.CanSet() || v.Type().Elem().Kind() != reflect.Struct {
+			for _, key := range v.MapKeys() {
+				localizeDates(v.MapIndex(key), loc)
+			}
+			return
+		}
+		for _, key := range v.MapKeys() {
+			value := reflect.New(v.Type().Elem()).Elem()
+			value.Set(v.MapIndex(key))
+			localizeDates(value, loc)
+			v.SetMapIndex(key, value)
+		}
+	}
+}
+
+// parseTimezone parses an IANA timezone name or a UTC/GMT offset
+func parseTimezone(timezone string) (*time.Location, error) {
+	timezone = strings.TrimSpace(timezone)
+	if match := timezoneOffsetRegexp.FindStringSubmatch(timezone); match != nil {
+		if match[1] == "" {
+			return time.UTC, nil
+		}
+		hours, _ := strconv.Atoi(match[2])
+		minutes, _ := strconv.Atoi(match[3])
+		if hours > 14 || minutes > 59 {
+			return nil, fmt.Errorf("invalid timezone offset %q", timezone)
+		}
+		offset := hours*60*60 + minutes*60
+		if match[1] == "-" {
+			offset = -offset
+		}
+		return time.FixedZone(strings.Trim(match[0], "() "), offset), nil
+	}
+	return time.LoadLocation(timezone)
 }
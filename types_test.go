@@ -0,0 +1,80 @@
+package sendpulse_sdk_go
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+func (suite *SendpulseTestSuite) TestDateTimeType_Location() {
+	suite.client.location = time.FixedZone("UTC+3", 3*60*60)
+	suite.mux.HandleFunc("/campaigns/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "send_date": "2021-07-15 15:39:02"}`)
+	})
+
+	campaign, err := suite.client.Emails.Campaigns.GetCampaign(context.Background(), 1)
+	suite.NoError(err)
+	suite.Equal(time.Date(2021, 7, 15, 12, 39, 2, 0, time.UTC), campaign.SendDate.Time().UTC())
+	suite.Equal("2021-07-15 15:39:02", campaign.SendDate.Format())
+
+	var d DateTimeType
+	suite.NoError(json.Unmarshal([]byte(`"2021-07-15 15:39:02"`), &d))
+	suite.Equal(time.Date(2021, 7, 15, 15, 39, 2, 0, time.UTC), d.Time())
+
+	other := NewClient(suite.client.client, &Config{UserID: "user", Secret: "secret"})
+	suite.Equal(time.UTC, other.Location())
+}
+
+func (suite *SendpulseTestSuite) TestDateTimeType_Zero() {
+	var d DateTimeType
+	b, err := json.Marshal(d)
+	suite.NoError(err)
+	suite.Equal("null", string(b))
+
+	suite.NoError(json.Unmarshal([]byte(`"0000-00-00 00:00:00"`), &d))
+	suite.True(d.IsZero())
+}
+
+func (suite *SendpulseTestSuite) TestDateTimeType_OmitInParams() {
+	b, err := json.Marshal(CampaignParams{Subject: "Hello"})
+	suite.NoError(err)
+	suite.NotContains(string(b), "send_date")
+
+	b, err = json.Marshal(CampaignParams{SendDate: NewDateTime(time.Date(2021, 7, 15, 15, 39, 2, 0, time.UTC))})
+	suite.NoError(err)
+	suite.Contains(string(b), `"send_date":"2021-07-15 15:39:02"`)
+
+	b, err = json.Marshal(CreateSmsCampaignByPhonesParams{Body: "Hello"})
+	suite.NoError(err)
+	suite.NotContains(string(b), `"date"`)
+}
+
+func (suite *SendpulseTestSuite) TestDateTimeType_AccountTimezone() {
+	suite.NoError(suite.client.SetAccountTimezone("(GMT+02:00) Kyiv"))
+	_, offset := time.Date(2021, 7, 15, 0, 0, 0, 0, suite.client.Location()).Zone()
+	suite.Equal(2*60*60, offset)
+
+	suite.NoError(suite.client.SetAccountTimezone("UTC-3:30"))
+	_, offset = time.Date(2021, 7, 15, 0, 0, 0, 0, suite.client.Location()).Zone()
+	suite.Equal(-(3*60*60 + 30*60), offset)
+
+	suite.NoError(suite.client.SetAccountTimezone("UTC"))
+	suite.Equal(time.UTC, suite.client.Location())
+	suite.Error(suite.client.SetAccountTimezone("Nowhere/Unknown"))
+
+	suite.NoError(suite.client.SetAccountTimezone("UTC+2"))
+	suite.mux.HandleFunc("/sms/send", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		suite.Contains(string(body), `"date":"2021-07-15 17:00:00"`)
+		fmt.Fprintf(w, `{"result": true, "campaign_id": 1}`)
+	})
+	_, err := suite.client.SMS.CreateCampaignByPhones(context.Background(), CreateSmsCampaignByPhonesParams{
+		Phones: []string{"380501234567"},
+		Body:   "Hello",
+		Date:   NewDateTime(time.Date(2021, 7, 15, 15, 0, 0, 0, time.UTC)),
+	})
+	suite.NoError(err)
+}
@@ -41,6 +41,12 @@ type CreateViberCampaignParams struct {
 
 func (service *ViberService) CreateCampaign(ctx context.Context, params CreateViberCampaignParams) (int, error) {
 	path := "/viber"
+	recipients, err := service.client.prepareIntPhones(params.Recipients)
+	if err != nil {
+		return 0, err
+	}
+	params.Recipients = recipients
+	params.SendDate = service.client.accountDate(params.SendDate)
 
 	var respData struct {
 		Result bool `json:"result"`
@@ -48,7 +54,7 @@ func (service *ViberService) CreateCampaign(ctx context.Context, params CreateVi
 			TaskID int `json:"task_id"`
 		} `json:"data"`
 	}
-	_, err := service.client.newRequest(ctx, http.MethodPost, path, params, &respData, true)
+	_, err = service.client.newRequest(ctx, http.MethodPost, path, params, &respData, true)
 	return respData.Data.TaskID, err
 }
 
@@ -68,6 +74,7 @@ type UpdateViberCampaignParams struct {
 
 func (service *ViberService) UpdateCampaign(ctx context.Context, params UpdateViberCampaignParams) error {
 	path := "/viber/update"
+	params.SendDate = service.client.accountDate(params.SendDate)
 
 	var respData struct {
 		Result bool `json:"result"`
@@ -142,6 +142,14 @@ type SendVkOkTemplateParams struct {
 
 func (service *VkOkService) Send(ctx context.Context, params SendVkOkTemplateParams) (int, error) {
 	path := "/vk-ok/campaigns"
+	params.Recipients = append(params.Recipients[:0:0], params.Recipients...)
+	for i := range params.Recipients {
+		phone, err := service.client.preparePhone(params.Recipients[i].Phone)
+		if err != nil {
+			return 0, err
+		}
+		params.Recipients[i].Phone = phone
+	}
 
 	var respData struct {
 		Total int `json:"total"`